@@ -27,6 +27,15 @@ const (
 	Jack
 	Queen
 	King
+
+	// Jokers do not belong to a suit.
+	// A red joker uses hearts, and a black joker uses spades.
+	Joker
+)
+
+var (
+	RedJoker   = Card{Rank: Joker, Suit: Hearts}
+	BlackJoker = Card{Rank: Joker, Suit: Spades}
 )
 
 type Card struct {
@@ -34,9 +43,18 @@ type Card struct {
 	Suit Suit
 }
 
+// Returns the card's name, in English.  Example: Ace of Spades or 10 of Hearts.
+// Use Words.CardName for other languages.
 func (c *Card) String() string {
+	if c.IsJoker() {
+		if c.Suit.IsRed() {
+			return "Red Joker"
+		}
+
+		return "Black Joker"
+	}
+
 	var suit string
-	// ♣️ ♦️ ♥️ ♠️
 	switch c.Suit {
 	case Clubs:
 		suit = "Clubs"
@@ -64,3 +82,8 @@ func (c *Card) String() string {
 
 	return fmt.Sprintf("%v of %v", rank, suit)
 }
+
+// Returns true if the card is a joker.
+func (c Card) IsJoker() bool {
+	return c.Rank == Joker
+}
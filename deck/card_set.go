@@ -0,0 +1,137 @@
+package deck
+
+import (
+	"iter"
+	"math/bits"
+)
+
+// A set of distinct cards, backed by a 64-bit mask.
+// Set operations do not allocate, making CardSet suitable for evaluators and simulators.
+//
+// Each suit uses 16 bits.  Bits 0 to 12 hold two to ace, and bit 13 holds the joker.  Clubs use
+// the lowest 16 bits, followed by diamonds, hearts and spades.
+//
+// A set cannot hold the same card twice.  Use a Hand for decks with duplicates, such as pinochle.
+type CardSet uint64
+
+const (
+	// Bits per suit.
+	suitWidth = 16
+
+	// Ranks two to ace.
+	rankMask = 1<<13 - 1
+)
+
+// Returns a set containing cards.
+// Duplicates and invalid cards are ignored.
+func NewCardSet(cards ...Card) CardSet {
+	var result CardSet
+	for _, card := range cards {
+		result.Add(card)
+	}
+
+	return result
+}
+
+// Adds a card to the set.
+// Invalid cards are ignored.
+func (s *CardSet) Add(c Card) {
+	if bit, ok := cardBit(c); ok {
+		*s |= bit
+	}
+}
+
+// Removes a card from the set.
+func (s *CardSet) Remove(c Card) {
+	if bit, ok := cardBit(c); ok {
+		*s &^= bit
+	}
+}
+
+// Returns true if the set contains the card.
+func (s CardSet) Contains(c Card) bool {
+	bit, ok := cardBit(c)
+	return ok && s&bit != 0
+}
+
+// Returns the cards found in either set.
+func (s CardSet) Union(other CardSet) CardSet {
+	return s | other
+}
+
+// Returns the cards found in both sets.
+func (s CardSet) Intersect(other CardSet) CardSet {
+	return s & other
+}
+
+// Returns the cards in s that are not in other.
+func (s CardSet) Difference(other CardSet) CardSet {
+	return s &^ other
+}
+
+// Returns the number of cards in the set.
+func (s CardSet) Count() int {
+	return bits.OnesCount64(uint64(s))
+}
+
+// Returns the ranks held in a suit, as a 13-bit mask.
+// Bit 0 is two, and bit 12 is ace.
+func (s CardSet) SuitMask(suit Suit) uint16 {
+	if suit < Clubs || suit > Spades {
+		return 0
+	}
+
+	return uint16(s>>(uint(suit-Clubs)*suitWidth)) & rankMask
+}
+
+// Iterates over the cards in rank order, from two to ace, with jokers last.
+// Cards of the same rank are returned in suit order.
+func (s CardSet) All() iter.Seq[Card] {
+	return func(yield func(Card) bool) {
+		for offset := 0; offset < suitWidth; offset++ {
+			for suit := Clubs; suit <= Spades; suit++ {
+				if s&(1<<(uint(suit-Clubs)*suitWidth+uint(offset))) == 0 {
+					continue
+				}
+
+				if !yield(bitCard(offset, suit)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Returns the cards as a hand, in rank order.
+func (s CardSet) Hand() Hand {
+	result := make(Hand, 0, s.Count())
+	for card := range s.All() {
+		result = append(result, card)
+	}
+
+	return result
+}
+
+// Returns the card's bit.
+// Returns false for invalid cards.
+func cardBit(c Card) (CardSet, bool) {
+	if !c.IsValid() {
+		return 0, false
+	}
+
+	offset := rankOrder(c.Rank) - int(Two)
+	return 1 << (uint(c.Suit-Clubs)*suitWidth + uint(offset)), true
+}
+
+// Returns the card at offset, within a suit.
+func bitCard(offset int, suit Suit) Card {
+	rank := Rank(offset + int(Two))
+	switch int(rank) {
+	case rankOrder(Ace):
+		rank = Ace
+	case rankOrder(Joker):
+		rank = Joker
+	}
+
+	return Card{rank, suit}
+}
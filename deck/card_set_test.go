@@ -0,0 +1,99 @@
+package deck_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/David-Rushton/card-collection/deck"
+)
+
+func Test_CardSet_AddRemoveContains(t *testing.T) {
+	var set deck.CardSet
+	aceOfSpades := deck.Card{Rank: deck.Ace, Suit: deck.Spades}
+
+	set.Add(aceOfSpades)
+	set.Add(aceOfSpades)
+	if !set.Contains(aceOfSpades) || set.Count() != 1 {
+		t.Errorf("❌ Unexpected set after add: %v.", set.Hand().ShortString())
+	}
+
+	set.Remove(aceOfSpades)
+	if set.Contains(aceOfSpades) || set.Count() != 0 {
+		t.Errorf("❌ Unexpected set after remove: %v.", set.Hand().ShortString())
+	}
+
+	set.Add(deck.Card{})
+	if set.Count() != 0 {
+		t.Errorf("❌ Invalid card was added to the set.")
+	}
+}
+
+func Test_CardSet_HoldsEveryCard(t *testing.T) {
+	cards := deck.StandardWithJokers.Cards()
+	set := deck.NewCardSet(cards...)
+
+	if set.Count() != 54 {
+		t.Errorf("❌ Unexpected count.  Expected: 54.  Actual: %v.", set.Count())
+	}
+
+	for _, card := range cards {
+		if !set.Contains(card) {
+			t.Errorf("❌ Set is missing %v.", card.String())
+		}
+	}
+}
+
+func Test_CardSet_SetOperations(t *testing.T) {
+	left := deck.NewCardSet(parseHand("Ah Kh Qh")...)
+	right := deck.NewCardSet(parseHand("Qh Jh Th")...)
+
+	testCases := []struct {
+		actual      deck.CardSet
+		expected    deck.Hand
+		description string
+	}{
+		{actual: left.Union(right), expected: parseHand("Th Jh Qh Kh Ah"), description: "Union"},
+		{actual: left.Intersect(right), expected: parseHand("Qh"), description: "Intersect"},
+		{actual: left.Difference(right), expected: parseHand("Kh Ah"), description: "Difference"},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.actual.Hand()
+		if !slices.Equal(actual, testCase.expected) {
+			t.Errorf("❌ %v failed.  Expected: %v.  Actual: %v.", testCase.description, testCase.expected.ShortString(), actual.ShortString())
+		}
+	}
+}
+
+func Test_CardSet_All_IteratesInRankOrder(t *testing.T) {
+	set := deck.NewCardSet(parseHand("As 2d Kc 2c Th")...)
+	set.Add(deck.RedJoker)
+
+	var actual deck.Hand
+	for card := range set.All() {
+		actual = append(actual, card)
+	}
+
+	expected := parseHand("2c 2d Th Kc As RJ")
+	if !slices.Equal(actual, expected) {
+		t.Errorf("❌ Unexpected order.  Expected: %v.  Actual: %v.", expected.ShortString(), actual.ShortString())
+	}
+}
+
+func Test_CardSet_SuitMask(t *testing.T) {
+	set := deck.NewCardSet(parseHand("2h 3h Ah As")...)
+
+	expected := uint16(1<<0 | 1<<1 | 1<<12)
+	if actual := set.SuitMask(deck.Hearts); actual != expected {
+		t.Errorf("❌ Unexpected mask.  Expected: %013b.  Actual: %013b.", expected, actual)
+	}
+}
+
+func Benchmark_CardSet_Contains(b *testing.B) {
+	set := deck.NewCardSet(parseHand("As Kd Qc Jh Th 9s 8d")...)
+	card := deck.Card{Rank: deck.Two, Suit: deck.Clubs}
+
+	for i := 0; i < b.N; i++ {
+		set.Contains(card)
+	}
+}
@@ -0,0 +1,97 @@
+package deck
+
+// The cards that make up a deck.
+// Different games use different decks.
+type Composition int
+
+const (
+	// The standard 52 cards.
+	Standard Composition = iota
+
+	// The standard 52 cards, plus a red and a black joker.
+	StandardWithJokers
+
+	// 32 cards.  Seven to ace, in each suit.
+	// Used in piquet, belote and skat.
+	Piquet
+
+	// 24 cards.  Nine to ace, in each suit.
+	Euchre
+
+	// 36 cards.  Six to ace, in each suit.
+	// Used in short deck hold'em.
+	ShortDeck
+
+	// 48 cards.  Two copies of each card from nine to ace.
+	Pinochle
+)
+
+// Returns the cards in the composition, in order.
+// Clubs first, then diamonds, hearts and spades.  Each suit runs from its lowest rank to ace.
+// Jokers, when included, are last.
+func (c Composition) Cards() Hand {
+	switch c {
+	case StandardWithJokers:
+		return append(standardCards(), BlackJoker, RedJoker)
+	case Piquet:
+		return cardsFrom(Seven, 1)
+	case Euchre:
+		return cardsFrom(Nine, 1)
+	case ShortDeck:
+		return cardsFrom(Six, 1)
+	case Pinochle:
+		return cardsFrom(Nine, 2)
+	default:
+		return standardCards()
+	}
+}
+
+// Returns the number of cards in the composition.
+func (c Composition) Size() int {
+	return len(c.Cards())
+}
+
+// Returns the ranks found in the composition, in rank order.
+func (c Composition) Ranks() []Rank {
+	var result []Rank
+	seen := make(map[Rank]bool)
+	for _, card := range c.Cards().Sort() {
+		if !seen[card.Rank] {
+			seen[card.Rank] = true
+			result = append(result, card.Rank)
+		}
+	}
+
+	return result
+}
+
+// Returns the 52 standard cards, in order.
+// Unlike the stripped decks, aces are first in each suit.
+func standardCards() Hand {
+	cards := make(Hand, 0, 52)
+	for suit := Clubs; suit <= Spades; suit++ {
+		for rank := Ace; rank <= King; rank++ {
+			cards = append(cards, Card{rank, suit})
+		}
+	}
+
+	return cards
+}
+
+// Returns copies of each card from lowest to ace, in every suit.
+func cardsFrom(lowest Rank, copies int) Hand {
+	var cards Hand
+	for suit := Clubs; suit <= Spades; suit++ {
+		for rank := lowest; rank <= King; rank++ {
+			for i := 0; i < copies; i++ {
+				cards = append(cards, Card{rank, suit})
+			}
+		}
+
+		for i := 0; i < copies; i++ {
+			cards = append(cards, Card{Ace, suit})
+		}
+	}
+
+	return cards
+}
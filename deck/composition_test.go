@@ -0,0 +1,110 @@
+package deck_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/David-Rushton/card-collection/deck"
+)
+
+func Test_Composition_ReturnsExpectedCards(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		composition  deck.Composition
+		expectedSize int
+		lowestRank   deck.Rank
+		copies       int
+	}{
+		{composition: deck.Standard, expectedSize: 52, lowestRank: deck.Two, copies: 1},
+		{composition: deck.StandardWithJokers, expectedSize: 54, lowestRank: deck.Two, copies: 1},
+		{composition: deck.Piquet, expectedSize: 32, lowestRank: deck.Seven, copies: 1},
+		{composition: deck.Euchre, expectedSize: 24, lowestRank: deck.Nine, copies: 1},
+		{composition: deck.ShortDeck, expectedSize: 36, lowestRank: deck.Six, copies: 1},
+		{composition: deck.Pinochle, expectedSize: 48, lowestRank: deck.Nine, copies: 2},
+	}
+
+	for _, testCase := range testCases {
+		d := deck.New(deck.WithComposition(testCase.composition), deck.WithSeed(1))
+		d.Shuffle()
+
+		if d.Remaining() != testCase.expectedSize {
+			t.Errorf("❌ Unexpected size for composition %v.  Expected: %v.  Actual: %v.", testCase.composition, testCase.expectedSize, d.Remaining())
+		}
+
+		cards, _ := d.Take(d.Remaining())
+		counts := make(map[deck.Card]int)
+		for _, card := range cards {
+			if !card.IsJoker() && card.Rank != deck.Ace && card.Rank < testCase.lowestRank {
+				t.Errorf("❌ Unexpected card in composition %v: %v.", testCase.composition, card.String())
+			}
+
+			counts[card]++
+		}
+
+		for card, count := range counts {
+			if count != testCase.copies {
+				t.Errorf("❌ Unexpected copies of %v in composition %v.  Expected: %v.  Actual: %v.", card.String(), testCase.composition, testCase.copies, count)
+			}
+		}
+	}
+}
+
+func Test_Composition_IncludesRedAndBlackJokers(t *testing.T) {
+	t.Parallel()
+
+	cards := deck.StandardWithJokers.Cards()
+	if !slices.Contains(cards, deck.RedJoker) || !slices.Contains(cards, deck.BlackJoker) {
+		t.Errorf("❌ Missing jokers: %v.", cards.ShortString())
+	}
+}
+
+func Test_Joker_String(t *testing.T) {
+	testCases := []struct {
+		card     deck.Card
+		expected string
+	}{
+		{card: deck.RedJoker, expected: "Red Joker"},
+		{card: deck.BlackJoker, expected: "Black Joker"},
+	}
+
+	for _, testCase := range testCases {
+		if actual := testCase.card.String(); actual != testCase.expected {
+			t.Errorf("❌ Unexpected string.  Expected: %v.  Actual: %v.", testCase.expected, actual)
+		}
+	}
+}
+
+func Test_Joker_SortsAfterAce(t *testing.T) {
+	actual := deck.Hand{deck.RedJoker, {Rank: deck.Ace, Suit: deck.Spades}, {Rank: deck.Two, Suit: deck.Clubs}}.Sort()
+
+	if actual[2] != deck.RedJoker {
+		t.Errorf("❌ Joker did not sort last: %v.", actual.ShortString())
+	}
+}
+
+func Test_Joker_RoundTrips(t *testing.T) {
+	hand, err := deck.ParseHand("rj BJ As")
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if hand.ShortString() != "RJ BJ As" {
+		t.Errorf("❌ Unexpected short string: %v.", hand.ShortString())
+	}
+
+	data, _ := hand.MarshalBinary()
+
+	var actual deck.Hand
+	if err := actual.UnmarshalBinary(data); err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if !slices.Equal(actual, hand) {
+		t.Errorf("❌ Binary round trip failed.  Expected: %v.  Actual: %v.", hand, actual)
+	}
+
+	if deck.RedJoker.Unicode() != "\U0001F0BF" || deck.BlackJoker.Unicode() != "\U0001F0CF" {
+		t.Errorf("❌ Unexpected joker code points.")
+	}
+}
@@ -1,56 +1,87 @@
 // A deck of 52 playing cards, in the classic [french-suited style].
+// Jokers and stripped decks are also supported.  See Composition.
 //
 // [french-suited style]: https://en.wikipedia.org/wiki/French-suited_playing_cards
 package deck
 
 import (
 	"errors"
-	"math/rand"
+	"slices"
 )
 
-var (
-	deck []Card
-)
+// A deck of cards.
+// Each deck owns its cards, so several decks can be used side by side.
+// The zero value is an empty deck.  Call Reset or Shuffle to fill it.
+type Deck struct {
+	cards       []Card
+	composition Composition
+	shuffler    shuffler
+}
 
-// Shuffles the deck.
-// Each card is moved to a random location.
-func Shuffle() {
-	// Reset the deck.
-	deck = make([]Card, 52)
-	for i := 0; i < 52; i++ {
-		deck[i] = Card{Rank(i % 13), Suit(i % 4)}
+// Returns a new deck, containing all 52 cards in order.
+// By default shuffles are seeded randomly.  Use options to control the source of randomness, and
+// the cards in the deck.
+func New(options ...Option) *Deck {
+	settings := newSettings(options...)
+	d := &Deck{
+		composition: settings.composition,
+		shuffler:    settings.shuffler,
 	}
+	d.Reset()
 
-	// Modern Fisher-Yates shuffle.
-	// https://en.wikipedia.org/wiki/Fisher%E2%80%93Yates_shuffle
-	for i := 51; i > 0; i-- {
-		swapAt := rand.Intn(i)
-		swap := deck[swapAt]
-		deck[swapAt] = deck[i]
-		deck[i] = swap
-	}
+	return d
+}
+
+// Returns all cards to the deck, in order.
+// Clubs first, then diamonds, hearts and spades.  For the standard deck each suit runs from ace to
+// king.
+func (d *Deck) Reset() {
+	d.cards = d.composition.Cards()
+}
+
+// Shuffles the deck.
+// All cards are returned to the deck, before each card is moved to a random location.
+func (d *Deck) Shuffle() {
+	d.Reset()
+	d.shuffler.shuffle(d.cards)
+}
+
+// Returns the seed used to shuffle the deck.
+// Returns false when the seed is unknown, because the deck was built with a custom source.
+func (d *Deck) Seed() (uint64, bool) {
+	return d.shuffler.seedUsed()
 }
 
 // Takes the top n cards from the deck.
 // If there are not enough cards returns ErrNotEnoughCards.
-func Take(n int) (Hand, error) {
+func (d *Deck) Take(n int) (Hand, error) {
+	result, err := d.Peek(n)
+	if err != nil {
+		return nil, err
+	}
+
+	d.cards = d.cards[n:]
+
+	return result, nil
+}
+
+// Returns the top n cards, without removing them from the deck.
+// If there are not enough cards returns ErrNotEnoughCards.
+func (d *Deck) Peek(n int) (Hand, error) {
 	// Validate.
 	if n < 0 {
 		return nil, errors.New("cannot take less than 0 card")
 	}
 
-	if len(deck) < n {
-		return nil, ErrNotEnoughCards{Requested: n, Remaining: len(deck)}
+	if len(d.cards) < n {
+		return nil, ErrNotEnoughCards{Requested: n, Remaining: len(d.cards)}
 	}
 
-	// Take.
-	result := deck[0:n]
-	deck = deck[n:]
-
-	return result, nil
+	// Copy, so the caller cannot modify the cards still in the deck.
+	return slices.Clone(d.cards[0:n]), nil
 }
 
 // Returns the number cards in the deck.
-func Remaining() int {
-	return len(deck)
+func (d *Deck) Remaining() int {
+	return len(d.cards)
 }
@@ -1,6 +1,7 @@
 package deck_test
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/David-Rushton/card-collection/deck"
@@ -74,3 +75,232 @@ func Test_Remaining_ReturnsExpectedCount(t *testing.T) {
 		}
 	}
 }
+
+func Test_New_Returns52CardsInOrder(t *testing.T) {
+	t.Parallel()
+
+	d := deck.New()
+
+	cards, err := d.Peek(52)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	expected := deck.Card{Rank: deck.Ace, Suit: deck.Clubs}
+	if cards[0] != expected {
+		t.Errorf("❌ Unexpected first card.  Expected: %v.  Actual: %v.", expected.String(), cards[0].String())
+	}
+
+	expected = deck.Card{Rank: deck.King, Suit: deck.Spades}
+	if cards[51] != expected {
+		t.Errorf("❌ Unexpected last card.  Expected: %v.  Actual: %v.", expected.String(), cards[51].String())
+	}
+}
+
+func Test_Deck_Shuffle_Returns52UniqueCards(t *testing.T) {
+	t.Parallel()
+
+	d := deck.New()
+	d.Shuffle()
+
+	cards, err := d.Take(52)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	keys := make(map[deck.Card]int)
+	for _, card := range cards {
+		if card.Rank < deck.Ace || card.Rank > deck.King || card.Suit < deck.Clubs || card.Suit > deck.Spades {
+			t.Errorf("❌ Invalid card: %v.", card)
+		}
+
+		keys[card]++
+	}
+
+	actual := len(keys)
+	if actual != 52 {
+		t.Errorf("❌ Expected: 52.  Actual: %v.", actual)
+	}
+}
+
+func Test_Deck_Take_DoesNotAffectOtherDecks(t *testing.T) {
+	t.Parallel()
+
+	d1 := deck.New()
+	d2 := deck.New()
+
+	if _, err := d1.Take(10); err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if d1.Remaining() != 42 {
+		t.Errorf("❌ Unexpected remaining cards.  Expected: 42.  Actual: %v.", d1.Remaining())
+	}
+
+	if d2.Remaining() != 52 {
+		t.Errorf("❌ Unexpected remaining cards.  Expected: 52.  Actual: %v.", d2.Remaining())
+	}
+}
+
+func Test_Deck_Peek_DoesNotRemoveCards(t *testing.T) {
+	t.Parallel()
+
+	d := deck.New()
+
+	peeked, err := d.Peek(5)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if d.Remaining() != 52 {
+		t.Errorf("❌ Unexpected remaining cards.  Expected: 52.  Actual: %v.", d.Remaining())
+	}
+
+	taken, err := d.Take(5)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if !slices.Equal(peeked, taken) {
+		t.Errorf("❌ Peek and Take disagree.  Peeked: %v.  Taken: %v.", peeked, taken)
+	}
+}
+
+func Test_Deck_Peek_ReturnsError_WhenNotEnoughCards(t *testing.T) {
+	t.Parallel()
+
+	d := deck.New()
+
+	if _, err := d.Peek(53); err == nil {
+		t.Errorf("❌ Missing ErrNotEnoughCards when peeking 53 cards.")
+	}
+
+	if _, err := d.Peek(-1); err == nil {
+		t.Errorf("❌ Missing error when peeking -1 cards.")
+	}
+}
+
+func Test_Deck_Reset_ReturnsAllCards(t *testing.T) {
+	t.Parallel()
+
+	d := deck.New()
+	d.Take(30)
+	d.Reset()
+
+	if d.Remaining() != 52 {
+		t.Errorf("❌ Unexpected remaining cards.  Expected: 52.  Actual: %v.", d.Remaining())
+	}
+}
+
+func Test_Deck_ZeroValue_IsEmpty(t *testing.T) {
+	t.Parallel()
+
+	var d deck.Deck
+
+	if d.Remaining() != 0 {
+		t.Errorf("❌ Unexpected remaining cards.  Expected: 0.  Actual: %v.", d.Remaining())
+	}
+}
+
+func Test_Deck_Shuffle_IsRepeatable_WhenSeeded(t *testing.T) {
+	t.Parallel()
+
+	d1 := deck.New(deck.WithSeed(42))
+	d2 := deck.New(deck.WithSeed(42))
+
+	for i := 0; i < 3; i++ {
+		d1.Shuffle()
+		d2.Shuffle()
+
+		cards1, _ := d1.Take(52)
+		cards2, _ := d2.Take(52)
+		if !slices.Equal(cards1, cards2) {
+			t.Errorf("❌ Decks with the same seed dealt different cards on shuffle %v.", i+1)
+		}
+	}
+}
+
+func Test_Deck_Shuffle_Differs_WhenSeedsDiffer(t *testing.T) {
+	t.Parallel()
+
+	d1 := deck.New(deck.WithSeed(1))
+	d2 := deck.New(deck.WithSeed(2))
+	d1.Shuffle()
+	d2.Shuffle()
+
+	cards1, _ := d1.Take(52)
+	cards2, _ := d2.Take(52)
+	if slices.Equal(cards1, cards2) {
+		t.Errorf("❌ Decks with different seeds dealt the same cards.")
+	}
+}
+
+func Test_Deck_Seed_ReturnsSeed(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		options        []deck.Option
+		expectedSeed   uint64
+		expectedSeeded bool
+	}{
+		{
+			options:        []deck.Option{deck.WithSeed(1234)},
+			expectedSeed:   1234,
+			expectedSeeded: true,
+		},
+		{
+			options:        []deck.Option{deck.WithSource(deck.CryptoSource())},
+			expectedSeed:   0,
+			expectedSeeded: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		seed, seeded := deck.New(testCase.options...).Seed()
+		if seed != testCase.expectedSeed || seeded != testCase.expectedSeeded {
+			t.Errorf(
+				"❌ Unexpected seed.  Expected: %v, %v.  Actual: %v, %v.",
+				testCase.expectedSeed,
+				testCase.expectedSeeded,
+				seed,
+				seeded)
+		}
+	}
+}
+
+func Test_Deck_Seed_ReplaysRandomlySeededDeck(t *testing.T) {
+	t.Parallel()
+
+	original := deck.New()
+	seed, seeded := original.Seed()
+	if !seeded {
+		t.Fatalf("❌ Default deck did not record its seed.")
+	}
+
+	replay := deck.New(deck.WithSeed(seed))
+	original.Shuffle()
+	replay.Shuffle()
+
+	cards1, _ := original.Take(52)
+	cards2, _ := replay.Take(52)
+	if !slices.Equal(cards1, cards2) {
+		t.Errorf("❌ Replaying seed %v dealt different cards.", seed)
+	}
+}
+
+func Test_Deck_Shuffle_Returns52UniqueCards_WithCryptoSource(t *testing.T) {
+	t.Parallel()
+
+	d := deck.New(deck.WithSource(deck.CryptoSource()))
+	d.Shuffle()
+
+	cards, _ := d.Take(52)
+	keys := make(map[deck.Card]int)
+	for _, card := range cards {
+		keys[card]++
+	}
+
+	if len(keys) != 52 {
+		t.Errorf("❌ Expected: 52.  Actual: %v.", len(keys))
+	}
+}
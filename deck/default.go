@@ -0,0 +1,24 @@
+package deck
+
+var (
+	// The package level deck.
+	// Used by Shuffle, Take and Remaining.
+	defaultDeck = &Deck{}
+)
+
+// Shuffles the package level deck.
+// Each card is moved to a random location.
+func Shuffle() {
+	defaultDeck.Shuffle()
+}
+
+// Takes the top n cards from the package level deck.
+// If there are not enough cards returns ErrNotEnoughCards.
+func Take(n int) (Hand, error) {
+	return defaultDeck.Take(n)
+}
+
+// Returns the number cards in the package level deck.
+func Remaining() int {
+	return defaultDeck.Remaining()
+}
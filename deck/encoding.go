@@ -0,0 +1,224 @@
+package deck
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Cards are encoded as short codes, such as "Ah", in text and JSON.
+// The binary form is a single byte per card.  Clubs are 0 to 12, running from ace to king,
+// followed by diamonds, hearts and spades.  The black joker is 52 and the red joker is 53.
+
+const (
+	blackJokerByte = 52
+	redJokerByte   = 53
+)
+
+// Returns true if the card's rank and suit are in range.
+// Jokers must be either RedJoker or BlackJoker.
+func (c Card) IsValid() bool {
+	if c.IsJoker() {
+		return c == RedJoker || c == BlackJoker
+	}
+
+	return c.Rank >= Ace && c.Rank <= King && c.Suit >= Clubs && c.Suit <= Spades
+}
+
+// Implements encoding.TextMarshaler.
+func (c Card) MarshalText() ([]byte, error) {
+	if !c.IsValid() {
+		return nil, outOfRange(c)
+	}
+
+	return []byte(c.ShortString()), nil
+}
+
+// Implements encoding.TextUnmarshaler.
+func (c *Card) UnmarshalText(text []byte) error {
+	card, err := ParseCard(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = card
+
+	return nil
+}
+
+// Implements json.Marshaler.
+func (c Card) MarshalJSON() ([]byte, error) {
+	text, err := c.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// Implements json.Unmarshaler.
+func (c *Card) UnmarshalJSON(data []byte) error {
+	var code string
+	if err := json.Unmarshal(data, &code); err != nil {
+		return err
+	}
+
+	return c.UnmarshalText([]byte(code))
+}
+
+// Implements encoding.BinaryMarshaler.
+func (c Card) MarshalBinary() ([]byte, error) {
+	b, err := encodeByte(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte{b}, nil
+}
+
+// Implements encoding.BinaryUnmarshaler.
+func (c *Card) UnmarshalBinary(data []byte) error {
+	if len(data) != 1 {
+		return fmt.Errorf("cannot decode card, expected 1 byte but found %d", len(data))
+	}
+
+	card, err := decodeByte(data[0])
+	if err != nil {
+		return err
+	}
+
+	*c = card
+
+	return nil
+}
+
+// Implements encoding.TextMarshaler.
+// Returns the short codes, separated by spaces.  Example: "Ah Td 9c".
+func (h Hand) MarshalText() ([]byte, error) {
+	codes := make([]string, len(h))
+	for i, card := range h {
+		text, err := card.MarshalText()
+		if err != nil {
+			return nil, err
+		}
+
+		codes[i] = string(text)
+	}
+
+	return []byte(strings.Join(codes, " ")), nil
+}
+
+// Implements encoding.TextUnmarshaler.
+// Unlike ParseHand, empty hands and duplicates are accepted.  A hand dealt from a shoe may
+// contain the same card more than once.
+func (h *Hand) UnmarshalText(text []byte) error {
+	fields := strings.Fields(string(text))
+
+	result := make(Hand, 0, len(fields))
+	for _, field := range fields {
+		card, err := ParseCard(field)
+		if err != nil {
+			return err
+		}
+
+		result = append(result, card)
+	}
+
+	*h = result
+
+	return nil
+}
+
+// Implements json.Marshaler.
+// Returns an array of short codes.  Example: ["Ah","Td","9c"].
+func (h Hand) MarshalJSON() ([]byte, error) {
+	if h == nil {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal([]Card(h))
+}
+
+// Implements json.Unmarshaler.
+func (h *Hand) UnmarshalJSON(data []byte) error {
+	var cards []Card
+	if err := json.Unmarshal(data, &cards); err != nil {
+		return err
+	}
+
+	*h = cards
+
+	return nil
+}
+
+// Implements encoding.BinaryMarshaler.
+// Returns one byte per card.
+func (h Hand) MarshalBinary() ([]byte, error) {
+	result := make([]byte, len(h))
+	for i, card := range h {
+		b, err := encodeByte(card)
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = b
+	}
+
+	return result, nil
+}
+
+// Implements encoding.BinaryUnmarshaler.
+func (h *Hand) UnmarshalBinary(data []byte) error {
+	result := make(Hand, len(data))
+	for i, b := range data {
+		card, err := decodeByte(b)
+		if err != nil {
+			return err
+		}
+
+		result[i] = card
+	}
+
+	*h = result
+
+	return nil
+}
+
+// Returns the single byte representation of a card.
+func encodeByte(c Card) (byte, error) {
+	if !c.IsValid() {
+		return 0, outOfRange(c)
+	}
+
+	switch c {
+	case BlackJoker:
+		return blackJokerByte, nil
+	case RedJoker:
+		return redJokerByte, nil
+	}
+
+	return byte(int(c.Suit-Clubs)*13 + int(c.Rank-Ace)), nil
+}
+
+// Returns the card represented by a single byte.
+func decodeByte(b byte) (Card, error) {
+	switch b {
+	case blackJokerByte:
+		return BlackJoker, nil
+	case redJokerByte:
+		return RedJoker, nil
+	}
+
+	if b >= 52 {
+		return Card{}, ErrInvalidCard{Input: fmt.Sprintf("0x%02x", b), Reason: "Byte out of range"}
+	}
+
+	return Card{Rank: Ace + Rank(b%13), Suit: Clubs + Suit(b/13)}, nil
+}
+
+func outOfRange(c Card) error {
+	return ErrInvalidCard{
+		Input:  fmt.Sprintf("{Rank: %d, Suit: %d}", c.Rank, c.Suit),
+		Reason: "Rank or suit out of range",
+	}
+}
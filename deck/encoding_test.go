@@ -0,0 +1,131 @@
+package deck_test
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/David-Rushton/card-collection/deck"
+)
+
+func Test_Card_MarshalJSON_UsesShortCode(t *testing.T) {
+	card := deck.Card{Rank: deck.Ace, Suit: deck.Hearts}
+
+	actual, err := json.Marshal(card)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if string(actual) != `"Ah"` {
+		t.Errorf("❌ Unexpected JSON.  Expected: \"Ah\".  Actual: %s.", actual)
+	}
+}
+
+func Test_Card_Marshal_ReturnsError_WhenOutOfRange(t *testing.T) {
+	testCases := []deck.Card{
+		{},
+		{Rank: deck.King + 1, Suit: deck.Clubs},
+		{Rank: deck.Ace, Suit: deck.Spades + 1},
+	}
+
+	for _, testCase := range testCases {
+		if _, err := json.Marshal(testCase); err == nil {
+			t.Errorf("❌ Missing JSON error for %v.", testCase)
+		}
+
+		if _, err := testCase.MarshalBinary(); err == nil {
+			t.Errorf("❌ Missing binary error for %v.", testCase)
+		}
+	}
+}
+
+func Test_Hand_JSON_RoundTrips(t *testing.T) {
+	hand := parseHand("Ah Td 9c 2s")
+
+	data, err := json.Marshal(hand)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if string(data) != `["Ah","Td","9c","2s"]` {
+		t.Errorf("❌ Unexpected JSON: %s.", data)
+	}
+
+	var actual deck.Hand
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if !slices.Equal(actual, hand) {
+		t.Errorf("❌ Round trip failed.  Expected: %v.  Actual: %v.", hand, actual)
+	}
+}
+
+func Test_Hand_UnmarshalJSON_ReturnsError_WhenCardInvalid(t *testing.T) {
+	testCases := []string{`["Ah","Zz"]`, `[1, 2]`, `"Ah"`}
+
+	for _, testCase := range testCases {
+		var hand deck.Hand
+		if err := json.Unmarshal([]byte(testCase), &hand); err == nil {
+			t.Errorf("❌ Missing error for %v.", testCase)
+		}
+	}
+}
+
+func Test_Hand_Text_RoundTrips(t *testing.T) {
+	hand := parseHand("Ah Ah Kc")
+
+	text, err := hand.MarshalText()
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	var actual deck.Hand
+	if err := actual.UnmarshalText(text); err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if !slices.Equal(actual, hand) {
+		t.Errorf("❌ Round trip failed.  Expected: %v.  Actual: %v.", hand, actual)
+	}
+}
+
+func Test_Hand_Binary_RoundTripsEveryCard(t *testing.T) {
+	hand, _ := deck.New().Peek(52)
+
+	data, err := hand.MarshalBinary()
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if len(data) != 52 {
+		t.Errorf("❌ Unexpected length.  Expected: 52.  Actual: %v.", len(data))
+	}
+
+	var actual deck.Hand
+	if err := actual.UnmarshalBinary(data); err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if !slices.Equal(actual, hand) {
+		t.Errorf("❌ Round trip failed.  Expected: %v.  Actual: %v.", hand, actual)
+	}
+}
+
+func Test_Card_UnmarshalBinary_ReturnsError_WhenOutOfRange(t *testing.T) {
+	testCases := [][]byte{{54}, {255}, {}, {1, 2}}
+
+	for _, testCase := range testCases {
+		var card deck.Card
+		if err := card.UnmarshalBinary(testCase); err == nil {
+			t.Errorf("❌ Missing error for %v.", testCase)
+		}
+	}
+
+	var invalid deck.ErrInvalidCard
+	var hand deck.Hand
+	if err := hand.UnmarshalBinary([]byte{0, 60}); !errors.As(err, &invalid) {
+		t.Errorf("❌ Unexpected error.  Expected: ErrInvalidCard.  Actual: %v.", err)
+	}
+}
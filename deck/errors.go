@@ -1,9 +1,21 @@
 package deck
 
 import (
+	"errors"
 	"fmt"
 )
 
+var (
+	// Returned when a shoe is built from less than one deck.
+	ErrInvalidDeckCount = errors.New("a shoe must contain at least one deck")
+
+	// Returned when the cut card is placed outside of the shoe.
+	ErrInvalidPenetration = errors.New("penetration must be greater than 0 and no more than 1")
+
+	// Returned when parsing a hand that does not contain any cards.
+	ErrEmptyHand = errors.New("cannot parse hand, no cards found")
+)
+
 type ErrNotEnoughCards struct {
 	Requested int
 	Remaining int
@@ -15,3 +27,22 @@ func (e ErrNotEnoughCards) Error() string {
 		e.Requested,
 		e.Remaining)
 }
+
+// Returned when a short code, such as "Ah", cannot be parsed.
+type ErrInvalidCard struct {
+	Input  string
+	Reason string
+}
+
+func (e ErrInvalidCard) Error() string {
+	return fmt.Sprintf("Cannot parse card %q.  %v.", e.Input, e.Reason)
+}
+
+// Returned when the same card appears more than once.
+type ErrDuplicateCard struct {
+	Card Card
+}
+
+func (e ErrDuplicateCard) Error() string {
+	return fmt.Sprintf("The %v appears more than once.", e.Card.String())
+}
@@ -147,9 +147,13 @@ func merge(left, right Hand) Hand {
 	return result
 }
 
+// Aces are high.  Jokers are higher still.
 func rankOrder(r Rank) int {
-	if r == Ace {
+	switch r {
+	case Ace:
 		return int(King) + 1
+	case Joker:
+		return int(King) + 2
 	}
 
 	return int(r)
@@ -2,7 +2,6 @@ package deck_test
 
 import (
 	"slices"
-	"strconv"
 	"strings"
 	"testing"
 
@@ -296,41 +295,17 @@ func Test_Sort(t *testing.T) {
 
 // Returns a hand parsed from short codes.
 // Example: 9d Tc Js Qh == 9 of diamonds, ten of clubs, jack of spades and queen of clubs.
+// Duplicates are allowed, so tests can describe any hand.
 func parseHand(requested string) deck.Hand {
 	var result deck.Hand
 
-	cards := strings.Split(requested, " ")
-	for _, c := range cards {
-		var rank deck.Rank
-		switch c[0] {
-		case 't', 'T':
-			rank = deck.Ten
-		case 'j', 'J':
-			rank = deck.Jack
-		case 'q', 'Q':
-			rank = deck.Queen
-		case 'k', 'K':
-			rank = deck.King
-		case 'a', 'A':
-			rank = deck.Ace
-		default:
-			num, _ := strconv.ParseInt(string(c[0]), 10, 64)
-			rank = deck.Rank(num)
+	for _, code := range strings.Split(requested, " ") {
+		card, err := deck.ParseCard(code)
+		if err != nil {
+			panic(err)
 		}
 
-		var suit deck.Suit
-		switch c[1] {
-		case 'c', 'C':
-			suit = deck.Clubs
-		case 'd', 'D':
-			suit = deck.Diamonds
-		case 'h', 'H':
-			suit = deck.Hearts
-		case 's', 'S':
-			suit = deck.Spades
-		}
-
-		result = append(result, deck.Card{rank, suit})
+		result = append(result, card)
 	}
 
 	return result
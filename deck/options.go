@@ -0,0 +1,31 @@
+package deck
+
+// Configures a new deck or shoe.
+type Option func(*settings)
+
+type settings struct {
+	shuffler    shuffler
+	composition Composition
+}
+
+// Returns the settings configured by options.
+// Without options we use the standard 52 cards, and shuffle with a random seed.
+func newSettings(options ...Option) settings {
+	s := settings{
+		shuffler:    randomShuffler(),
+		composition: Standard,
+	}
+
+	for _, option := range options {
+		option(&s)
+	}
+
+	return s
+}
+
+// Builds the deck from composition, instead of the standard 52 cards.
+func WithComposition(composition Composition) Option {
+	return func(s *settings) {
+		s.composition = composition
+	}
+}
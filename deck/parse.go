@@ -0,0 +1,139 @@
+package deck
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// Short code characters, indexed by rank.
+	rankCodes = "?A23456789TJQK"
+
+	// Short code characters, indexed by suit.
+	suitCodes = "?cdhs"
+
+	redJokerCode   = "RJ"
+	blackJokerCode = "BJ"
+)
+
+// Returns the card described by a short code.
+// A short code is a rank followed by a suit.  Example: "Ah" is the ace of hearts.
+//
+//   - Ranks: A 2 3 4 5 6 7 8 9 T J Q K.  10 is accepted as an alternative to T.
+//   - Suits: c d h s.
+//
+// Jokers are written RJ and BJ, for red and black.
+// All codes are case-insensitive.
+// If the code cannot be parsed returns ErrInvalidCard.
+func ParseCard(code string) (Card, error) {
+	trimmed := strings.TrimSpace(code)
+
+	switch strings.ToUpper(trimmed) {
+	case redJokerCode:
+		return RedJoker, nil
+	case blackJokerCode:
+		return BlackJoker, nil
+	}
+
+	// 10 is the only rank that needs two characters.
+	rankCode := trimmed
+	if strings.HasPrefix(rankCode, "10") {
+		rankCode = "T" + rankCode[2:]
+	}
+
+	if len(rankCode) != 2 {
+		return Card{}, ErrInvalidCard{Input: code, Reason: "Expected a rank followed by a suit"}
+	}
+
+	rank := Rank(strings.IndexByte(rankCodes, upper(rankCode[0])))
+	if rank < Ace {
+		return Card{}, ErrInvalidCard{Input: code, Reason: fmt.Sprintf("Unknown rank %q", rankCode[0])}
+	}
+
+	suit := Suit(strings.IndexByte(suitCodes, lower(rankCode[1])))
+	if suit < Clubs {
+		return Card{}, ErrInvalidCard{Input: code, Reason: fmt.Sprintf("Unknown suit %q", rankCode[1])}
+	}
+
+	return Card{rank, suit}, nil
+}
+
+// Returns the hand described by a list of short codes, separated by whitespace.
+// Example: "Ah Td 9c".
+//
+// Returns ErrEmptyHand when there are no cards, ErrInvalidCard when a code cannot be parsed and
+// ErrDuplicateCard when a card appears more than once.
+func ParseHand(codes string) (Hand, error) {
+	fields := strings.Fields(codes)
+	if len(fields) == 0 {
+		return nil, ErrEmptyHand
+	}
+
+	result := make(Hand, 0, len(fields))
+	var seen CardSet
+	for _, field := range fields {
+		card, err := ParseCard(field)
+		if err != nil {
+			return nil, err
+		}
+
+		if seen.Contains(card) {
+			return nil, ErrDuplicateCard{Card: card}
+		}
+
+		seen.Add(card)
+		result = append(result, card)
+	}
+
+	return result, nil
+}
+
+// Returns the card's short code.  Example: "Ah" for the ace of hearts.
+// Unknown ranks and suits are shown as ?.
+func (c Card) ShortString() string {
+	if c.IsValid() && c.IsJoker() {
+		if c.Suit.IsRed() {
+			return redJokerCode
+		}
+
+		return blackJokerCode
+	}
+
+	rank := rankCodes[0]
+	if c.Rank >= Ace && c.Rank <= King {
+		rank = rankCodes[c.Rank]
+	}
+
+	suit := suitCodes[0]
+	if c.Suit >= Clubs && c.Suit <= Spades {
+		suit = suitCodes[c.Suit]
+	}
+
+	return string([]byte{rank, suit})
+}
+
+// Returns the short code for each card, separated by spaces.  Example: "Ah Td 9c".
+func (h Hand) ShortString() string {
+	codes := make([]string, len(h))
+	for i, card := range h {
+		codes[i] = card.ShortString()
+	}
+
+	return strings.Join(codes, " ")
+}
+
+func upper(b byte) byte {
+	if b >= 'a' && b <= 'z' {
+		return b - 'a' + 'A'
+	}
+
+	return b
+}
+
+func lower(b byte) byte {
+	if b >= 'A' && b <= 'Z' {
+		return b - 'A' + 'a'
+	}
+
+	return b
+}
@@ -0,0 +1,121 @@
+package deck_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/David-Rushton/card-collection/deck"
+)
+
+func Test_ParseCard_ReturnsCard(t *testing.T) {
+	testCases := []struct {
+		code     string
+		expected deck.Card
+	}{
+		{code: "Ah", expected: deck.Card{Rank: deck.Ace, Suit: deck.Hearts}},
+		{code: "as", expected: deck.Card{Rank: deck.Ace, Suit: deck.Spades}},
+		{code: "2c", expected: deck.Card{Rank: deck.Two, Suit: deck.Clubs}},
+		{code: "Td", expected: deck.Card{Rank: deck.Ten, Suit: deck.Diamonds}},
+		{code: "10D", expected: deck.Card{Rank: deck.Ten, Suit: deck.Diamonds}},
+		{code: " kS ", expected: deck.Card{Rank: deck.King, Suit: deck.Spades}},
+	}
+
+	for _, testCase := range testCases {
+		actual, err := deck.ParseCard(testCase.code)
+		if err != nil {
+			t.Errorf("❌ Unexpected error parsing %q: %v.", testCase.code, err)
+		}
+
+		if actual != testCase.expected {
+			t.Errorf("❌ Unexpected card for %q.  Expected: %v.  Actual: %v.", testCase.code, testCase.expected.String(), actual.String())
+		}
+	}
+}
+
+func Test_ParseCard_ReturnsErrInvalidCard(t *testing.T) {
+	testCases := []string{"", "A", "Ahh", "1h", "Xh", "Ax", "?c", "100h"}
+
+	for _, testCase := range testCases {
+		_, err := deck.ParseCard(testCase)
+
+		var invalid deck.ErrInvalidCard
+		if !errors.As(err, &invalid) {
+			t.Errorf("❌ Unexpected error for %q.  Expected: ErrInvalidCard.  Actual: %v.", testCase, err)
+		}
+	}
+}
+
+func Test_ParseHand_ReturnsHand(t *testing.T) {
+	actual, err := deck.ParseHand("Ah  Td\t9c")
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	expected := deck.Hand{
+		{Rank: deck.Ace, Suit: deck.Hearts},
+		{Rank: deck.Ten, Suit: deck.Diamonds},
+		{Rank: deck.Nine, Suit: deck.Clubs},
+	}
+	if !slices.Equal(actual, expected) {
+		t.Errorf("❌ Unexpected hand.  Expected: %v.  Actual: %v.", expected, actual)
+	}
+}
+
+func Test_ParseHand_ReturnsErrors(t *testing.T) {
+	testCases := []struct {
+		codes    string
+		expected func(error) bool
+	}{
+		{
+			codes:    "",
+			expected: func(err error) bool { return err == deck.ErrEmptyHand },
+		},
+		{
+			codes:    "   ",
+			expected: func(err error) bool { return err == deck.ErrEmptyHand },
+		},
+		{
+			codes: "Ah Zd",
+			expected: func(err error) bool {
+				var invalid deck.ErrInvalidCard
+				return errors.As(err, &invalid) && invalid.Input == "Zd"
+			},
+		},
+		{
+			codes: "Ah Kd ah",
+			expected: func(err error) bool {
+				var duplicate deck.ErrDuplicateCard
+				return errors.As(err, &duplicate) && duplicate.Card == deck.Card{Rank: deck.Ace, Suit: deck.Hearts}
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		_, err := deck.ParseHand(testCase.codes)
+		if !testCase.expected(err) {
+			t.Errorf("❌ Unexpected error for %q: %v.", testCase.codes, err)
+		}
+	}
+}
+
+func Test_ShortString_RoundTrips(t *testing.T) {
+	codes := "Ac 2d 3h 4s 5c 6d 7h 8s 9c Td Jh Qs Kc"
+
+	hand, err := deck.ParseHand(codes)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	actual := hand.ShortString()
+	if actual != codes {
+		t.Errorf("❌ Round trip failed.  Expected: %v.  Actual: %v.", codes, actual)
+	}
+}
+
+func Test_ShortString_ShowsUnknownValues(t *testing.T) {
+	actual := deck.Card{}.ShortString()
+	if actual != "??" {
+		t.Errorf("❌ Unexpected short string.  Expected: ??.  Actual: %v.", actual)
+	}
+}
@@ -0,0 +1,96 @@
+package deck
+
+import (
+	cryptorand "crypto/rand"
+	"encoding/binary"
+	mathrand "math/rand"
+	"math/rand/v2"
+)
+
+// Shuffles using a generator seeded with seed.
+// Decks built with the same seed, and shuffled the same number of times, deal the same cards.
+func WithSeed(seed uint64) Option {
+	return func(s *settings) {
+		s.shuffler = seededShuffler(seed)
+	}
+}
+
+// Shuffles using the caller supplied source.
+// A *rand.Rand, from either math/rand or math/rand/v2, is also a valid source.
+// The seed is unknown, so Seed returns false.
+func WithSource(source rand.Source) Option {
+	return func(s *settings) {
+		s.shuffler = shuffler{rng: rand.New(source)}
+	}
+}
+
+// Shuffles using a source from the math/rand package.
+func WithLegacySource(source mathrand.Source) Option {
+	return WithSource(mathrand.New(source))
+}
+
+// Returns a source backed by crypto/rand.
+// Use for real money tables, where a predictable shuffle is unacceptable.
+// Decks using this source cannot be replayed.
+func CryptoSource() rand.Source {
+	return cryptoSource{}
+}
+
+type cryptoSource struct{}
+
+func (cryptoSource) Uint64() uint64 {
+	var buf [8]byte
+	if _, err := cryptorand.Read(buf[:]); err != nil {
+		panic("deck: crypto/rand failed: " + err.Error())
+	}
+
+	return binary.LittleEndian.Uint64(buf[:])
+}
+
+// Moves cards to random locations.
+// Tracks the seed, when known, so a shuffle can be replayed.
+type shuffler struct {
+	rng    *rand.Rand
+	seed   uint64
+	seeded bool
+}
+
+// Returns a shuffler seeded with seed.
+func seededShuffler(seed uint64) shuffler {
+	return shuffler{
+		rng:    rand.New(rand.NewPCG(seed, seed)),
+		seed:   seed,
+		seeded: true,
+	}
+}
+
+// Returns a shuffler with a random seed.
+// The seed is recorded, so the shuffle can be replayed.
+func randomShuffler() shuffler {
+	return seededShuffler(rand.Uint64())
+}
+
+// The zero value has no generator.  Creates one on first use.
+func (s *shuffler) ready() {
+	if s.rng == nil {
+		*s = randomShuffler()
+	}
+}
+
+// Returns the seed, and true when it is known.
+func (s *shuffler) seedUsed() (uint64, bool) {
+	s.ready()
+	return s.seed, s.seeded
+}
+
+// Shuffles cards in place.
+func (s *shuffler) shuffle(cards []Card) {
+	s.ready()
+
+	// Modern Fisher-Yates shuffle.
+	// https://en.wikipedia.org/wiki/Fisher%E2%80%93Yates_shuffle
+	for i := len(cards) - 1; i > 0; i-- {
+		swapAt := s.rng.IntN(i + 1)
+		cards[swapAt], cards[i] = cards[i], cards[swapAt]
+	}
+}
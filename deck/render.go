@@ -0,0 +1,228 @@
+package deck
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Renderers, for showing cards in a terminal.
+
+const (
+	ansiRed   = "\x1b[31m"
+	ansiReset = "\x1b[0m"
+
+	// The [playing cards] block starts with the back of a card.
+	//
+	// [playing cards]: https://en.wikipedia.org/wiki/Playing_cards_in_Unicode
+	unicodeCardBack   = 0x1F0A0
+	unicodeRedJoker   = 0x1F0BF
+	unicodeBlackJoker = 0x1F0CF
+)
+
+// Returns the suit's symbol.  Example: ♠.
+func (s Suit) Symbol() string {
+	switch s {
+	case Clubs:
+		return "♣"
+	case Diamonds:
+		return "♦"
+	case Hearts:
+		return "♥"
+	case Spades:
+		return "♠"
+	default:
+		return "?"
+	}
+}
+
+// Returns true for diamonds and hearts.
+func (s Suit) IsRed() bool {
+	return s == Diamonds || s == Hearts
+}
+
+// Returns the card as a single Unicode playing card.  Example: 🂡 for the ace of spades.
+// Unknown cards are shown face down.
+func (c Card) Unicode() string {
+	switch {
+	case !c.IsValid():
+		return string(rune(unicodeCardBack))
+	case c == RedJoker:
+		return string(rune(unicodeRedJoker))
+	case c == BlackJoker:
+		return string(rune(unicodeBlackJoker))
+	}
+
+	// Each suit occupies a row of 16 code points.  Spades first, then hearts, diamonds and clubs.
+	var row int
+	switch c.Suit {
+	case Spades:
+		row = 0x00
+	case Hearts:
+		row = 0x10
+	case Diamonds:
+		row = 0x20
+	case Clubs:
+		row = 0x30
+	}
+
+	// The knight, used in tarot decks, sits between the jack and queen.
+	column := int(c.Rank)
+	if c.Rank >= Queen {
+		column++
+	}
+
+	return string(rune(unicodeCardBack + row + column))
+}
+
+// Returns the card's rank and suit symbol.  Example: A♠.
+// Jokers are shown as JK★.
+func (c Card) Symbol() string {
+	return c.rankLabel() + c.suitLabel()
+}
+
+// Returns the card's rank and suit symbol.
+// Red suits are coloured, using ANSI escape codes.
+func (c Card) ColouredSymbol() string {
+	return colour(c.Suit, c.Symbol())
+}
+
+// Returns the card, drawn as ASCII art.  One string per line.
+//
+//	.-------.
+//	|10     |
+//	|       |
+//	|   ♥   |
+//	|       |
+//	|     10|
+//	'-------'
+func (c Card) Art() []string {
+	return c.art(false)
+}
+
+// Returns the card, drawn as ASCII art.
+// Red suits are coloured, using ANSI escape codes.
+func (c Card) ColouredArt() []string {
+	return c.art(true)
+}
+
+// Returns each card as a Unicode playing card, separated by spaces.
+func (h Hand) Unicode() string {
+	return h.join(Card.Unicode)
+}
+
+// Returns each card's rank and suit symbol, separated by spaces.  Example: A♠ 10♥ 9♣.
+func (h Hand) Symbols() string {
+	return h.join(Card.Symbol)
+}
+
+// Returns each card's rank and suit symbol, separated by spaces.
+// Red suits are coloured, using ANSI escape codes.
+func (h Hand) ColouredSymbols() string {
+	return h.join(Card.ColouredSymbol)
+}
+
+// Returns the hand drawn as ASCII art, with the cards laid out side by side.
+func (h Hand) Art() string {
+	return h.artRows(Card.Art)
+}
+
+// Returns the hand drawn as ASCII art, with the cards laid out side by side.
+// Red suits are coloured, using ANSI escape codes.
+func (h Hand) ColouredArt() string {
+	return h.artRows(Card.ColouredArt)
+}
+
+func (h Hand) join(render func(Card) string) string {
+	result := make([]string, len(h))
+	for i, card := range h {
+		result[i] = render(card)
+	}
+
+	return strings.Join(result, " ")
+}
+
+func (h Hand) artRows(render func(Card) []string) string {
+	var rows []string
+	for _, card := range h {
+		lines := render(card)
+		if rows == nil {
+			rows = make([]string, len(lines))
+		}
+
+		for i, line := range lines {
+			if len(rows[i]) > 0 {
+				rows[i] += " "
+			}
+
+			rows[i] += line
+		}
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+func (c Card) art(coloured bool) []string {
+	rank := c.rankLabel()
+	suit := c.suitLabel()
+
+	// Pad before colouring, as escape codes take up space but are not shown.
+	top := fmt.Sprintf("%-7s", rank)
+	middle := fmt.Sprintf("   %s   ", suit)
+	bottom := fmt.Sprintf("%7s", rank)
+	if coloured {
+		top = colour(c.Suit, top)
+		middle = colour(c.Suit, middle)
+		bottom = colour(c.Suit, bottom)
+	}
+
+	return []string{
+		".-------.",
+		"|" + top + "|",
+		"|       |",
+		"|" + middle + "|",
+		"|       |",
+		"|" + bottom + "|",
+		"'-------'",
+	}
+}
+
+// Returns the rank as shown on the face of a card.  Example: A, 10 or K.
+func (c Card) rankLabel() string {
+	switch c.Rank {
+	case Ace:
+		return "A"
+	case Jack:
+		return "J"
+	case Queen:
+		return "Q"
+	case King:
+		return "K"
+	case Joker:
+		return "JK"
+	}
+
+	if c.Rank > Ace && c.Rank < Jack {
+		return fmt.Sprintf("%d", int(c.Rank))
+	}
+
+	return "?"
+}
+
+// Returns the suit as shown on the face of a card.
+// Jokers do not have a suit, and are shown with a star.
+func (c Card) suitLabel() string {
+	if c.IsJoker() {
+		return "★"
+	}
+
+	return c.Suit.Symbol()
+}
+
+// Wraps text in ANSI escape codes, when the suit is red.
+func colour(suit Suit, text string) string {
+	if !suit.IsRed() {
+		return text
+	}
+
+	return ansiRed + text + ansiReset
+}
@@ -0,0 +1,61 @@
+package deck_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/David-Rushton/card-collection/deck"
+)
+
+func Test_Unicode_ReturnsPlayingCard(t *testing.T) {
+	testCases := []struct {
+		code     string
+		expected string
+	}{
+		{code: "As", expected: "\U0001F0A1"},
+		{code: "Th", expected: "\U0001F0BA"},
+		{code: "Jd", expected: "\U0001F0CB"},
+		{code: "Qc", expected: "\U0001F0DD"},
+		{code: "Ks", expected: "\U0001F0AE"},
+	}
+
+	for _, testCase := range testCases {
+		card, _ := deck.ParseCard(testCase.code)
+
+		actual := card.Unicode()
+		if actual != testCase.expected {
+			t.Errorf("❌ Unexpected code point for %v.  Expected: %U.  Actual: %U.", testCase.code, []rune(testCase.expected)[0], []rune(actual)[0])
+		}
+	}
+}
+
+func Test_Symbols_ColoursRedSuits(t *testing.T) {
+	hand := parseHand("As Th")
+
+	if actual := hand.Symbols(); actual != "A♠ 10♥" {
+		t.Errorf("❌ Unexpected symbols.  Expected: A♠ 10♥.  Actual: %v.", actual)
+	}
+
+	expected := "A♠ \x1b[31m10♥\x1b[0m"
+	if actual := hand.ColouredSymbols(); actual != expected {
+		t.Errorf("❌ Unexpected coloured symbols.  Expected: %q.  Actual: %q.", expected, actual)
+	}
+}
+
+func Test_Art_LaysCardsSideBySide(t *testing.T) {
+	actual := parseHand("As Th 9c").Art()
+
+	expected := strings.Join([]string{
+		".-------. .-------. .-------.",
+		"|A      | |10     | |9      |",
+		"|       | |       | |       |",
+		"|   ♠   | |   ♥   | |   ♣   |",
+		"|       | |       | |       |",
+		"|      A| |     10| |      9|",
+		"'-------' '-------' '-------'",
+	}, "\n")
+
+	if actual != expected {
+		t.Errorf("❌ Unexpected art.\nExpected:\n%v\nActual:\n%v", expected, actual)
+	}
+}
@@ -0,0 +1,154 @@
+package deck
+
+import (
+	"errors"
+	"slices"
+)
+
+const (
+	// Where the cut card is placed, unless configured otherwise.
+	// Three quarters of the shoe is dealt before reshuffling.
+	DefaultPenetration = 0.75
+)
+
+// A shoe holds several decks, shuffled together.
+// Used by casino games, such as blackjack and baccarat.
+//
+// A cut card is placed part way through the shoe.  Once the cut card is reached the table should
+// finish the current round, and then shuffle.
+type Shoe struct {
+	decks       int
+	composition Composition
+	penetration float64
+	cards       []Card
+	dealt       int
+	cutCard     int
+	shuffler    shuffler
+}
+
+// Returns a new shoe, containing n decks in order.
+// If n is less than one returns ErrInvalidDeckCount.
+func NewShoe(n int, options ...Option) (*Shoe, error) {
+	if n < 1 {
+		return nil, ErrInvalidDeckCount
+	}
+
+	settings := newSettings(options...)
+	s := &Shoe{
+		decks:       n,
+		composition: settings.composition,
+		penetration: DefaultPenetration,
+		shuffler:    settings.shuffler,
+	}
+	s.Reset()
+
+	return s, nil
+}
+
+// Returns all cards to the shoe, in order, and places the cut card.
+func (s *Shoe) Reset() {
+	s.cards = nil
+	for i := 0; i < s.decks; i++ {
+		s.cards = append(s.cards, s.composition.Cards()...)
+	}
+
+	s.dealt = 0
+	s.cutCard = int(float64(len(s.cards)) * s.penetration)
+}
+
+// Shuffles the shoe.
+// All cards are returned to the shoe, and the cut card is placed at the configured penetration.
+func (s *Shoe) Shuffle() {
+	s.Reset()
+	s.shuffler.shuffle(s.cards)
+}
+
+// Sets where the cut card is placed, as a fraction of the shoe.
+// 0.75 places the cut card after three quarters of the cards.  1 deals the entire shoe.
+// Takes effect on the next shuffle.
+func (s *Shoe) SetPenetration(penetration float64) error {
+	if penetration <= 0 || penetration > 1 {
+		return ErrInvalidPenetration
+	}
+
+	s.penetration = penetration
+
+	return nil
+}
+
+// Returns where the cut card is placed, as a fraction of the shoe.
+func (s *Shoe) Penetration() float64 {
+	return s.penetration
+}
+
+// Returns the number of decks in the shoe.
+func (s *Shoe) Decks() int {
+	return s.decks
+}
+
+// Returns the seed used to shuffle the shoe.
+// Returns false when the seed is unknown, because the shoe was built with a custom source.
+func (s *Shoe) Seed() (uint64, bool) {
+	return s.shuffler.seedUsed()
+}
+
+// Takes the next n cards from the shoe.
+// Dealing continues past the cut card, so the current round can be completed.
+// If there are not enough cards returns ErrNotEnoughCards.
+func (s *Shoe) Take(n int) (Hand, error) {
+	// Validate.
+	if n < 0 {
+		return nil, errors.New("cannot take less than 0 card")
+	}
+
+	if s.Remaining() < n {
+		return nil, ErrNotEnoughCards{Requested: n, Remaining: s.Remaining()}
+	}
+
+	// Take.
+	result := slices.Clone(s.cards[s.dealt : s.dealt+n])
+	s.dealt += n
+
+	return result, nil
+}
+
+// Returns true once the cut card has been reached.
+// The table should shuffle before the next round.
+func (s *Shoe) CutCardReached() bool {
+	return s.dealt >= s.cutCard
+}
+
+// Returns the number of cards dealt since the last shuffle.
+func (s *Shoe) Dealt() int {
+	return s.dealt
+}
+
+// Returns the number of cards left in the shoe.
+func (s *Shoe) Remaining() int {
+	return len(s.cards) - s.dealt
+}
+
+// Returns the number of cards dealt since the last shuffle, grouped by rank.
+func (s *Shoe) DealtByRank() map[Rank]int {
+	return s.countByRank(s.cards[:s.dealt])
+}
+
+// Returns the number of cards left in the shoe, grouped by rank.
+func (s *Shoe) RemainingByRank() map[Rank]int {
+	return s.countByRank(s.cards[s.dealt:])
+}
+
+// Counts cards by rank.
+// Every rank in the composition is included, even when the count is zero.
+func (s *Shoe) countByRank(cards []Card) map[Rank]int {
+	result := make(map[Rank]int)
+	for _, rank := range s.composition.Ranks() {
+		result[rank] = 0
+	}
+
+	for _, card := range cards {
+		result[card.Rank]++
+	}
+
+	return result
+}
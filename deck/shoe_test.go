@@ -0,0 +1,141 @@
+package deck_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/David-Rushton/card-collection/deck"
+)
+
+func Test_NewShoe_ContainsNDecks(t *testing.T) {
+	t.Parallel()
+
+	testCases := []int{1, 6, 8}
+
+	for _, testCase := range testCases {
+		shoe, err := deck.NewShoe(testCase)
+		if err != nil {
+			t.Fatalf("❌ Unexpected error: %v.", err)
+		}
+
+		expected := testCase * 52
+		actual := shoe.Remaining()
+		if actual != expected {
+			t.Errorf("❌ Unexpected shoe size.  Expected: %v.  Actual: %v.", expected, actual)
+		}
+
+		for rank, count := range shoe.RemainingByRank() {
+			if count != testCase*4 {
+				t.Errorf("❌ Unexpected count for rank %v.  Expected: %v.  Actual: %v.", rank, testCase*4, count)
+			}
+		}
+	}
+}
+
+func Test_NewShoe_ReturnsErrInvalidDeckCount_WhenLessThanOne(t *testing.T) {
+	t.Parallel()
+
+	testCases := []int{0, -1}
+
+	for _, testCase := range testCases {
+		if _, err := deck.NewShoe(testCase); err != deck.ErrInvalidDeckCount {
+			t.Errorf("❌ Unexpected error for %v decks.  Expected: ErrInvalidDeckCount.  Actual: %v.", testCase, err)
+		}
+	}
+}
+
+func Test_Shoe_CutCardReached_AtPenetration(t *testing.T) {
+	t.Parallel()
+
+	shoe, _ := deck.NewShoe(6, deck.WithSeed(7))
+	if err := shoe.SetPenetration(0.5); err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+	shoe.Shuffle()
+
+	// 6 decks * 52 cards * 0.5 penetration.
+	if _, err := shoe.Take(155); err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if shoe.CutCardReached() {
+		t.Errorf("❌ Cut card reached early, after %v cards.", shoe.Dealt())
+	}
+
+	shoe.Take(1)
+	if !shoe.CutCardReached() {
+		t.Errorf("❌ Cut card not reached, after %v cards.", shoe.Dealt())
+	}
+
+	shoe.Shuffle()
+	if shoe.CutCardReached() {
+		t.Errorf("❌ Cut card still reached after shuffle.")
+	}
+}
+
+func Test_Shoe_SetPenetration_ReturnsError_WhenOutOfRange(t *testing.T) {
+	t.Parallel()
+
+	testCases := []float64{0, -0.5, 1.01}
+
+	shoe, _ := deck.NewShoe(1)
+	for _, testCase := range testCases {
+		if err := shoe.SetPenetration(testCase); err != deck.ErrInvalidPenetration {
+			t.Errorf("❌ Unexpected error for %v.  Expected: ErrInvalidPenetration.  Actual: %v.", testCase, err)
+		}
+	}
+}
+
+func Test_Shoe_ByRank_TracksDealtCards(t *testing.T) {
+	t.Parallel()
+
+	shoe, _ := deck.NewShoe(2, deck.WithSeed(99))
+	shoe.Shuffle()
+
+	cards, _ := shoe.Take(20)
+
+	dealt := shoe.DealtByRank()
+	remaining := shoe.RemainingByRank()
+	for rank := deck.Ace; rank <= deck.King; rank++ {
+		expected := 0
+		for _, card := range cards {
+			if card.Rank == rank {
+				expected++
+			}
+		}
+
+		if dealt[rank] != expected {
+			t.Errorf("❌ Unexpected dealt count for rank %v.  Expected: %v.  Actual: %v.", rank, expected, dealt[rank])
+		}
+
+		if dealt[rank]+remaining[rank] != 8 {
+			t.Errorf("❌ Dealt and remaining do not total 8 for rank %v.", rank)
+		}
+	}
+}
+
+func Test_Shoe_Take_ReturnsError_WhenNotEnoughCards(t *testing.T) {
+	t.Parallel()
+
+	shoe, _ := deck.NewShoe(1)
+	shoe.Take(50)
+
+	if _, err := shoe.Take(3); err == nil {
+		t.Errorf("❌ Missing ErrNotEnoughCards when requesting 3 cards.")
+	}
+}
+
+func Test_Shoe_Shuffle_IsRepeatable_WhenSeeded(t *testing.T) {
+	t.Parallel()
+
+	shoe1, _ := deck.NewShoe(6, deck.WithSeed(3))
+	shoe2, _ := deck.NewShoe(6, deck.WithSeed(3))
+	shoe1.Shuffle()
+	shoe2.Shuffle()
+
+	cards1, _ := shoe1.Take(shoe1.Remaining())
+	cards2, _ := shoe2.Take(shoe2.Remaining())
+	if !slices.Equal(cards1, cards2) {
+		t.Errorf("❌ Shoes with the same seed dealt different cards.")
+	}
+}
@@ -0,0 +1,102 @@
+package deck
+
+// The words used to name ranks, suits and cards.  Used by descriptions of hands.
+//
+// The zero value uses English.  Set fields to describe cards in another language.  Nil fields
+// continue to use English.
+type Words struct {
+	// The name of a rank.  Example: Six.
+	Rank func(Rank) string
+
+	// The plural name of a rank.  Example: Sixes.
+	Plural func(Rank) string
+
+	// The name of a suit.  Example: Hearts.
+	Suit func(Suit) string
+
+	// Joins rank and suit names into the name of a card.  Example: Six of Hearts.
+	Card func(rank, suit string) string
+
+	// The name of a joker.  Example: Red Joker.
+	Joker func(Card) string
+}
+
+var (
+	englishRanks = map[Rank]string{
+		Ace:   "Ace",
+		Two:   "Two",
+		Three: "Three",
+		Four:  "Four",
+		Five:  "Five",
+		Six:   "Six",
+		Seven: "Seven",
+		Eight: "Eight",
+		Nine:  "Nine",
+		Ten:   "Ten",
+		Jack:  "Jack",
+		Queen: "Queen",
+		King:  "King",
+		Joker: "Joker",
+	}
+
+	englishSuits = map[Suit]string{
+		Clubs:    "Clubs",
+		Diamonds: "Diamonds",
+		Hearts:   "Hearts",
+		Spades:   "Spades",
+	}
+)
+
+// Returns the name of a rank.
+func (w Words) RankName(r Rank) string {
+	if w.Rank != nil {
+		return w.Rank(r)
+	}
+
+	return englishRanks[r]
+}
+
+// Returns the plural name of a rank.
+func (w Words) PluralName(r Rank) string {
+	if w.Plural != nil {
+		return w.Plural(r)
+	}
+
+	if r == Six {
+		return "Sixes"
+	}
+
+	return englishRanks[r] + "s"
+}
+
+// Returns the name of a suit.
+func (w Words) SuitName(s Suit) string {
+	if w.Suit != nil {
+		return w.Suit(s)
+	}
+
+	return englishSuits[s]
+}
+
+// Returns the name of a card.  Example: Ace of Spades.
+func (w Words) CardName(c Card) string {
+	if c.IsJoker() {
+		if w.Joker != nil {
+			return w.Joker(c)
+		}
+
+		if c.Suit.IsRed() {
+			return "Red Joker"
+		}
+
+		return "Black Joker"
+	}
+
+	rank := w.RankName(c.Rank)
+	suit := w.SuitName(c.Suit)
+	if w.Card != nil {
+		return w.Card(rank, suit)
+	}
+
+	return rank + " of " + suit
+}
@@ -0,0 +1,48 @@
+package deck_test
+
+import (
+	"testing"
+
+	"github.com/David-Rushton/card-collection/deck"
+)
+
+func Test_Card_String(t *testing.T) {
+	testCases := []struct {
+		card     deck.Card
+		expected string
+	}{
+		{deck.Card{Rank: deck.Ace, Suit: deck.Spades}, "Ace of Spades"},
+		{deck.Card{Rank: deck.Ten, Suit: deck.Hearts}, "10 of Hearts"},
+		{deck.Card{Rank: deck.Queen, Suit: deck.Diamonds}, "Queen of Diamonds"},
+		{deck.RedJoker, "Red Joker"},
+		{deck.Card{}, "0 of "},
+	}
+
+	for _, testCase := range testCases {
+		if actual := testCase.card.String(); actual != testCase.expected {
+			t.Errorf("❌ Unexpected string.  Expected: %v.  Actual: %v.", testCase.expected, actual)
+		}
+	}
+}
+
+func Test_Words_ReplaceEnglish(t *testing.T) {
+	french := deck.Words{
+		Rank: func(r deck.Rank) string { return map[deck.Rank]string{deck.Ace: "As"}[r] },
+		Suit: func(s deck.Suit) string { return map[deck.Suit]string{deck.Spades: "Pique"}[s] },
+		Card: func(rank, suit string) string { return rank + " de " + suit },
+	}
+
+	ace := deck.Card{Rank: deck.Ace, Suit: deck.Spades}
+	if actual := french.CardName(ace); actual != "As de Pique" {
+		t.Errorf("❌ Unexpected name.  Expected: As de Pique.  Actual: %v.", actual)
+	}
+
+	// Fields left nil continue to use English.
+	if actual := french.PluralName(deck.Six); actual != "Sixes" {
+		t.Errorf("❌ Unexpected plural.  Expected: Sixes.  Actual: %v.", actual)
+	}
+
+	if actual := french.CardName(deck.RedJoker); actual != "Red Joker" {
+		t.Errorf("❌ Unexpected joker.  Expected: Red Joker.  Actual: %v.", actual)
+	}
+}
@@ -0,0 +1,51 @@
+package holdem
+
+import "fmt"
+
+// The kinds of action a player can take.
+type ActionKind int
+
+const (
+	// Gives up the hand.
+	Fold ActionKind = iota
+
+	// Passes, when there is nothing to call.
+	Check
+
+	// Matches the current bet.  A player without enough chips calls all in.
+	Call
+
+	// Opens the betting for a round.
+	Bet
+
+	// Increases the current bet.
+	Raise
+)
+
+// A player's decision.
+type Action struct {
+	Kind ActionKind
+
+	// For bets and raises, the total the player has put in during the betting round, once the
+	// action is complete.  Raises are made to an amount, not by an amount.
+	// Ignored by other actions.
+	Amount int
+}
+
+// Example: raise to 40.
+func (a Action) String() string {
+	switch a.Kind {
+	case Fold:
+		return "fold"
+	case Check:
+		return "check"
+	case Call:
+		return "call"
+	case Bet:
+		return fmt.Sprintf("bet %d", a.Amount)
+	case Raise:
+		return fmt.Sprintf("raise to %d", a.Amount)
+	default:
+		return fmt.Sprintf("ActionKind(%d)", int(a.Kind))
+	}
+}
@@ -0,0 +1,63 @@
+package holdem
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// Returned when a table's blinds or ante are invalid.
+	ErrInvalidRules = errors.New("big blind must be positive, and no smaller than the small blind or ante")
+
+	// Returned when fewer than two players can afford to play.
+	ErrNotEnoughPlayers = errors.New("at least two players with chips are required")
+
+	// Returned when dealing a new hand before the current hand is complete.
+	ErrHandInProgress = errors.New("the current hand is not complete")
+
+	// Returned when dealing a new hand before the last hand's pot has been paid.  See Hand.Settle.
+	ErrPotUnsettled = errors.New("the last hand's pot has not been paid")
+
+	// Returned when acting on a hand that is complete.
+	ErrHandComplete = errors.New("the hand is complete")
+)
+
+// Returned when a table has too many players.
+type ErrTooManyPlayers struct {
+	Max    int
+	Actual int
+}
+
+func (e ErrTooManyPlayers) Error() string {
+	return fmt.Sprintf("Expected no more than %d players but found %d.", e.Max, e.Actual)
+}
+
+// Returned when two players share a name, or a player is missing an account.
+type ErrInvalidPlayer struct {
+	Name   string
+	Reason string
+}
+
+func (e ErrInvalidPlayer) Error() string {
+	return fmt.Sprintf("Invalid player %q.  %v.", e.Name, e.Reason)
+}
+
+// Returned when a player acts out of turn.
+type ErrNotYourTurn struct {
+	Player   string
+	Expected string
+}
+
+func (e ErrNotYourTurn) Error() string {
+	return fmt.Sprintf("It is %v's turn, not %v's.", e.Expected, e.Player)
+}
+
+// Returned when an action breaks the rules.
+type ErrIllegalAction struct {
+	Action Action
+	Reason string
+}
+
+func (e ErrIllegalAction) Error() string {
+	return fmt.Sprintf("Cannot %v.  %v.", e.Action, e.Reason)
+}
@@ -0,0 +1,15 @@
+package holdem
+
+import (
+	"testing"
+
+	"github.com/David-Rushton/card-collection/house"
+)
+
+// Replaces the rule deciding who receives odd chips, until the test ends.  The rule runs while the
+// house pays the pot.
+func SetOddChipRule(t *testing.T, rule func(seats ...*house.Account) house.OddChipRule) {
+	previous := oddChipRule
+	oddChipRule = rule
+	t.Cleanup(func() { oddChipRule = previous })
+}
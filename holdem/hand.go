@@ -0,0 +1,592 @@
+package holdem
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/David-Rushton/card-collection/deck"
+	"github.com/David-Rushton/card-collection/house"
+	"github.com/David-Rushton/card-collection/poker"
+)
+
+// The stages of a hand.
+type Stage int
+
+const (
+	PreFlop Stage = iota
+	Flop
+	Turn
+	River
+
+	// The pot has been paid out.  No more actions are accepted.
+	Complete
+)
+
+func (s Stage) String() string {
+	switch s {
+	case PreFlop:
+		return "pre-flop"
+	case Flop:
+		return "flop"
+	case Turn:
+		return "turn"
+	case River:
+		return "river"
+	case Complete:
+		return "complete"
+	default:
+		return fmt.Sprintf("Stage(%d)", int(s))
+	}
+}
+
+// Decides who receives the chips left over when a pot cannot be split evenly.  Replaced in tests.
+var oddChipRule = house.SeatOrder
+
+// How a hand ended.
+type Result struct {
+	// The players who won the main pot.  More than one when the pot is split.
+	// Listed from the first seat left of the button.
+	Winners []string
+
+	// The chips each player received, from the main pot and any side pots.  Includes bets that
+	// nobody called.
+	Won map[string]int
+
+	// The best hand of each player at showdown.
+	// Nil when every other player folded, as no cards are shown.
+	Hands map[string]poker.PokerHand
+}
+
+// A single hand of Texas hold'em.
+//
+// The hand moves through its stages as players act.  Use ToAct to find the next player, and Act to
+// play their turn.  Once every betting round is complete, or only one player remains, the pot is
+// paid out and the stage is Complete.
+//
+// A player who is all in can only win the chips they matched.  The house divides the pot into
+// side pots, and odd chips go to the first winner left of the button.
+type Hand struct {
+	rules Rules
+	deck  *deck.Deck
+	house *house.House
+	seats []*seat
+
+	// Indexes into seats.
+	button int
+	toAct  int
+
+	stage Stage
+	board deck.Hand
+
+	// The amount each player must put in during this round.
+	currentBet int
+
+	// The smallest raise allowed.  The size of the last full bet or raise.
+	minRaise int
+
+	result *Result
+
+	// The best hand of each player at showdown, kept to retry the payout.
+	shown map[string]poker.PokerHand
+
+	// Moves the hand's chips, tagging them with the hand's reference in the house ledger.
+	session house.Session
+}
+
+// A player's place in a hand.
+type seat struct {
+	player Player
+	hole   deck.Hand
+	folded bool
+
+	// Chips put in during the current betting round.  Antes are not included.
+	committed int
+
+	// True when the player has acted since the last full bet or raise.
+	acted bool
+
+	// True when the player may only call or fold.  Set when an all-in raise, smaller than a full
+	// raise, is made after the player has acted.
+	raiseClosed bool
+}
+
+// True when the player has put all their chips in.
+func (s *seat) isAllIn() bool {
+	return !s.folded && s.player.Account.Available() == 0
+}
+
+// True when the player may still act in the hand.
+func (s *seat) canAct() bool {
+	return !s.folded && !s.isAllIn()
+}
+
+// Starts a hand.  Posts antes and blinds, and deals the hole cards.
+// Returns the house's error when a hand that ends before any action cannot be paid out.
+func newHand(rules Rules, players []Player, button int, cards *deck.Deck, bank *house.House, session house.Session) (*Hand, error) {
+	h := &Hand{
+		rules:    rules,
+		deck:     cards,
+		house:    bank,
+		session:  session,
+		button:   button,
+		stage:    PreFlop,
+		minRaise: rules.BigBlind,
+	}
+
+	for _, player := range players {
+		h.seats = append(h.seats, &seat{player: player})
+	}
+
+	for _, s := range h.seats {
+		h.post(s, rules.Ante, false)
+	}
+
+	// Heads up, the button posts the small blind.
+	smallBlind := h.next(button)
+	if len(h.seats) == 2 {
+		smallBlind = button
+	}
+	bigBlind := h.next(smallBlind)
+
+	h.post(h.seats[smallBlind], rules.SmallBlind, true)
+	h.post(h.seats[bigBlind], rules.BigBlind, true)
+	h.currentBet = max(h.seats[smallBlind].committed, h.seats[bigBlind].committed)
+
+	// One card at a time, starting left of the button.
+	for range 2 {
+		for i := range h.seats {
+			s := h.seats[h.next(button+i)]
+			s.hole = append(s.hole, h.take(1)...)
+		}
+	}
+
+	// Players whose blinds could not be posted sit out, which may leave one player.
+	if h.remaining() <= 1 {
+		return h, h.settle(nil)
+	}
+
+	h.toAct = h.nextToAct(bigBlind)
+	if h.toAct < 0 {
+		return h, h.nextStage()
+	}
+
+	return h, nil
+}
+
+// Returns the reference used to find the hand's bets and payouts.  See house.House.EntriesForHand.
+func (h *Hand) Reference() house.Reference {
+	return h.session.Reference()
+}
+
+// Returns the current stage.
+func (h *Hand) Stage() Stage {
+	return h.stage
+}
+
+// Returns the community cards dealt so far.
+func (h *Hand) Board() deck.Hand {
+	return slices.Clone(h.board)
+}
+
+// Returns a player's hole cards.
+// Returns false when the player is not in the hand.
+func (h *Hand) HoleCards(player string) (deck.Hand, bool) {
+	for _, s := range h.seats {
+		if s.player.Name == player {
+			return slices.Clone(s.hole), true
+		}
+	}
+
+	return nil, false
+}
+
+// Returns the chips in the pot.
+func (h *Hand) Pot() int {
+	return h.house.PotBalance()
+}
+
+// Returns the player whose turn it is.
+// Returns false when the hand is complete.
+func (h *Hand) ToAct() (string, bool) {
+	if h.stage == Complete {
+		return "", false
+	}
+
+	return h.seats[h.toAct].player.Name, true
+}
+
+// Returns the amount the player to act must put in to call.
+func (h *Hand) ToCall() int {
+	if h.stage == Complete {
+		return 0
+	}
+
+	s := h.seats[h.toAct]
+	return min(h.currentBet-s.committed, s.player.Account.Available())
+}
+
+// Returns the smallest amount the player to act may bet or raise to.
+// A player without enough chips may still go all in for less.
+func (h *Hand) MinRaiseTo() int {
+	return h.currentBet + h.minRaise
+}
+
+// Returns the outcome of the hand.
+// Returns false until the hand is complete.
+func (h *Hand) Result() (Result, bool) {
+	if h.result == nil {
+		return Result{}, false
+	}
+
+	return *h.result, true
+}
+
+// Plays the player's turn.
+//
+// Returns ErrHandComplete when the hand is over, ErrNotYourTurn when another player is due to act,
+// and ErrIllegalAction when the action breaks the rules.  Errors from the house, such as
+// house.ErrAccountClosed, are returned when the player's chips cannot be moved.  The hand is
+// unchanged when an error is returned, apart from payout errors.
+//
+// When the house cannot pay the winners the hand is complete, without a result, and its error is
+// returned.  The chips stay in the pot until Settle pays them.
+func (h *Hand) Act(player string, action Action) error {
+	if h.stage == Complete {
+		return ErrHandComplete
+	}
+
+	s := h.seats[h.toAct]
+	if s.player.Name != player {
+		return ErrNotYourTurn{Player: player, Expected: s.player.Name}
+	}
+
+	if err := h.apply(s, action); err != nil {
+		return err
+	}
+
+	s.acted = true
+
+	return h.advance()
+}
+
+// Checks the action is legal, and moves the chips.
+func (h *Hand) apply(s *seat, action Action) error {
+	illegal := func(reason string, args ...any) error {
+		return ErrIllegalAction{Action: action, Reason: fmt.Sprintf(reason, args...)}
+	}
+
+	toCall := h.currentBet - s.committed
+	stack := s.player.Account.Available()
+
+	switch action.Kind {
+	case Fold:
+		s.folded = true
+		h.house.Forfeit(s.player.Account)
+
+	case Check:
+		if toCall > 0 {
+			return illegal("There is a bet of %d to call", toCall)
+		}
+
+	case Call:
+		if toCall == 0 {
+			return illegal("There is nothing to call")
+		}
+
+		if err := h.commit(s, min(toCall, stack)); err != nil {
+			return err
+		}
+
+	case Bet, Raise:
+		switch {
+		case action.Kind == Bet && h.currentBet > 0:
+			return illegal("There is already a bet.  Raise instead")
+		case action.Kind == Raise && h.currentBet == 0:
+			return illegal("There is no bet to raise.  Bet instead")
+		case s.raiseClosed:
+			return illegal("The betting has not been reopened.  Call or fold instead")
+		}
+
+		additional := action.Amount - s.committed
+		if additional > stack {
+			return illegal("Only %d chips are available", stack+s.committed)
+		}
+
+		increase := action.Amount - h.currentBet
+		allIn := additional == stack
+		if increase <= 0 || (increase < h.minRaise && !allIn) {
+			return illegal("The minimum is %d", h.MinRaiseTo())
+		}
+
+		if err := h.commit(s, additional); err != nil {
+			return err
+		}
+
+		h.currentBet = action.Amount
+
+		// A full raise reopens the betting.  A smaller all-in raise must be called, but cannot be
+		// re-raised by players who have already acted.
+		fullRaise := increase >= h.minRaise
+		if fullRaise {
+			h.minRaise = increase
+		}
+
+		for _, other := range h.seats {
+			if other == s {
+				continue
+			}
+
+			other.raiseClosed = !fullRaise && other.acted
+			other.acted = false
+		}
+
+	default:
+		return illegal("Unknown action")
+	}
+
+	return nil
+}
+
+// Moves to the next player, stage or the end of the hand.
+func (h *Hand) advance() error {
+	if h.remaining() <= 1 {
+		return h.settle(nil)
+	}
+
+	if next := h.nextToAct(h.toAct); next >= 0 {
+		h.toAct = next
+		return nil
+	}
+
+	return h.nextStage()
+}
+
+// Deals the next street, and starts its betting round.
+// When fewer than two players can bet, the remaining cards are dealt without betting.
+func (h *Hand) nextStage() error {
+	for _, s := range h.seats {
+		s.committed = 0
+		s.acted = false
+		s.raiseClosed = false
+	}
+
+	h.currentBet = 0
+	h.minRaise = h.rules.BigBlind
+
+	switch h.stage {
+	case PreFlop:
+		h.burnAndTurn(3)
+		h.stage = Flop
+	case Flop:
+		h.burnAndTurn(1)
+		h.stage = Turn
+	case Turn:
+		h.burnAndTurn(1)
+		h.stage = River
+	default:
+		return h.showdown()
+	}
+
+	h.toAct = h.nextToAct(h.button)
+	if h.toAct < 0 {
+		return h.nextStage()
+	}
+
+	return nil
+}
+
+// Returns the next player, after from, who must act in the current round.
+// Returns -1 when the round is complete.
+func (h *Hand) nextToAct(from int) int {
+	actors := 0
+	for _, s := range h.seats {
+		if s.canAct() {
+			actors++
+		}
+	}
+
+	for i := 1; i <= len(h.seats); i++ {
+		index := (from + i) % len(h.seats)
+		s := h.seats[index]
+		switch {
+		case !s.canAct():
+			continue
+		case s.committed < h.currentBet:
+			return index
+		case !s.acted && actors > 1:
+			// Players with nothing to call only act when someone could respond.
+			return index
+		}
+	}
+
+	return -1
+}
+
+// Compares the hands of the players still in, and pays the winners.
+func (h *Hand) showdown() error {
+	hands := make(map[string]poker.PokerHand)
+	for _, s := range h.seats {
+		if !s.folded {
+			hands[s.player.Name] = poker.BestHand(h.board.Append(s.hole, len(s.hole)))
+		}
+	}
+
+	return h.settle(hands)
+}
+
+// Retries paying the pot, after the house refused to pay the winners.  Players whose accounts have
+// since closed lose their claim, so their share goes to the next best hand.  A pot without an
+// eligible winner stays in the house, and is added to the next hand's pot.
+//
+// Returns ErrHandInProgress when the hand is not complete, and the house's error when it still
+// cannot pay.  Does nothing when the pot has been paid.
+func (h *Hand) Settle() error {
+	if h.stage != Complete {
+		return ErrHandInProgress
+	}
+
+	if h.result != nil {
+		return nil
+	}
+
+	return h.payout()
+}
+
+// Pays each pot to the best hands, or to the last player standing when hands is nil.
+// The hand is complete, even when the house cannot pay.
+func (h *Hand) settle(hands map[string]poker.PokerHand) error {
+	h.stage = Complete
+	h.shown = hands
+
+	return h.payout()
+}
+
+// Pays each pot to the best hands shown.
+func (h *Hand) payout() error {
+	hands := h.shown
+
+	// Seats from the first left of the button.  Decides who receives odd chips.
+	var seatOrder []*house.Account
+	var live []*seat
+	for i := range h.seats {
+		s := h.seats[h.next(h.button+i)]
+		seatOrder = append(seatOrder, s.player.Account)
+		if !s.folded {
+			live = append(live, s)
+		}
+	}
+
+	// Best hand first.  Equal hands share a group.
+	slices.SortStableFunc(live, func(a, b *seat) int {
+		return cmp.Compare(hands[b.player.Name].Score, hands[a.player.Name].Score)
+	})
+
+	var ranking [][]*house.Account
+	for i, s := range live {
+		if i > 0 && hands[s.player.Name].Score == hands[live[i-1].player.Name].Score {
+			ranking[len(ranking)-1] = append(ranking[len(ranking)-1], s.player.Account)
+			continue
+		}
+
+		ranking = append(ranking, []*house.Account{s.player.Account})
+	}
+
+	awards, err := h.session.PayoutPots(ranking, oddChipRule(seatOrder...))
+	if err != nil {
+		return err
+	}
+
+	result := &Result{Won: make(map[string]int), Hands: hands}
+	for _, award := range awards {
+		s := h.seatFor(award.Account)
+		result.Won[s.player.Name] += award.Amount
+		if award.Pot == 0 {
+			result.Winners = append(result.Winners, s.player.Name)
+		}
+	}
+
+	h.result = result
+
+	return nil
+}
+
+// Returns the seat holding the account.
+func (h *Hand) seatFor(account *house.Account) *seat {
+	for _, s := range h.seats {
+		if s.player.Account == account {
+			return s
+		}
+	}
+
+	return nil
+}
+
+// Moves a blind or ante into the pot.  Players without enough chips post what they have.
+// Blinds count towards the player's bet in the first round.
+//
+// A player whose chips the house refuses, such as when their account has been closed, sits out the
+// hand.
+func (h *Hand) post(s *seat, amount int, blind bool) {
+	amount = min(amount, s.player.Account.Available())
+	if amount == 0 || s.folded {
+		return
+	}
+
+	if err := h.session.Bet(s.player.Account, amount); err != nil {
+		s.folded = true
+		h.house.Forfeit(s.player.Account)
+		return
+	}
+
+	if blind {
+		s.committed += amount
+	}
+}
+
+// Moves chips from the player into the pot.
+// The player's bet is unchanged when the house refuses the chips.
+func (h *Hand) commit(s *seat, amount int) error {
+	// Amounts are checked against the player's balance before reaching here.
+	if err := h.session.Bet(s.player.Account, amount); err != nil {
+		return err
+	}
+
+	s.committed += amount
+
+	return nil
+}
+
+// Returns the number of players who have not folded.
+func (h *Hand) remaining() int {
+	result := 0
+	for _, s := range h.seats {
+		if !s.folded {
+			result++
+		}
+	}
+
+	return result
+}
+
+// Discards a card, then deals n cards to the board.
+func (h *Hand) burnAndTurn(n int) {
+	h.take(1)
+	h.board = append(h.board, h.take(n)...)
+}
+
+// Takes cards from the deck.
+// A table never seats more players than the deck can serve.
+func (h *Hand) take(n int) deck.Hand {
+	cards, err := h.deck.Take(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return cards
+}
+
+// Returns the seat after index, wrapping around the table.
+func (h *Hand) next(index int) int {
+	return (index + 1) % len(h.seats)
+}
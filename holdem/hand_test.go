@@ -0,0 +1,563 @@
+package holdem_test
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/David-Rushton/card-collection/deck"
+	"github.com/David-Rushton/card-collection/holdem"
+	"github.com/David-Rushton/card-collection/house"
+	"github.com/David-Rushton/card-collection/poker"
+)
+
+var (
+	fold  = holdem.Action{Kind: holdem.Fold}
+	check = holdem.Action{Kind: holdem.Check}
+	call  = holdem.Action{Kind: holdem.Call}
+)
+
+func Test_Deal_PostsBlindsAndAntes(t *testing.T) {
+	table, players := newTable(t, holdem.Rules{SmallBlind: 5, BigBlind: 10, Ante: 1}, 1000, 1000, 1000)
+	hand := deal(t, table)
+
+	assertBalances(t, players, 999, 994, 989)
+	if hand.Pot() != 18 {
+		t.Errorf("❌ Unexpected pot.  Expected: 18.  Actual: %v.", hand.Pot())
+	}
+
+	if button, _ := table.Button(); button != "alice" {
+		t.Errorf("❌ Unexpected button.  Expected: alice.  Actual: %v.", button)
+	}
+
+	assertToAct(t, hand, "alice")
+	if hand.ToCall() != 10 || hand.MinRaiseTo() != 20 {
+		t.Errorf("❌ Unexpected bet sizes.  To call: %v.  Min raise to: %v.", hand.ToCall(), hand.MinRaiseTo())
+	}
+
+	var dealt deck.CardSet
+	for _, player := range players {
+		hole, _ := hand.HoleCards(player.Name)
+		if len(hole) != 2 {
+			t.Errorf("❌ Expected 2 hole cards for %v.  Actual: %v.", player.Name, hole)
+		}
+
+		dealt = dealt.Union(deck.NewCardSet(hole...))
+	}
+
+	if dealt.Count() != 6 {
+		t.Errorf("❌ Expected 6 distinct hole cards.  Actual: %v.", dealt.Count())
+	}
+}
+
+func Test_Hand_PaysLastPlayerStanding(t *testing.T) {
+	table, players := newTable(t, holdem.Rules{SmallBlind: 5, BigBlind: 10, Ante: 1}, 1000, 1000, 1000)
+	hand := deal(t, table)
+
+	act(t, hand, "alice", fold)
+	act(t, hand, "bob", fold)
+
+	result, ok := hand.Result()
+	if !ok || hand.Stage() != holdem.Complete {
+		t.Fatalf("❌ Expected the hand to be complete.  Actual: %v.", hand.Stage())
+	}
+
+	if !slices.Equal(result.Winners, []string{"cara"}) || result.Won["cara"] != 18 || result.Hands != nil {
+		t.Errorf("❌ Unexpected result: %+v.", result)
+	}
+
+	assertBalances(t, players, 999, 994, 1007)
+
+	if err := hand.Act("cara", check); !errors.Is(err, holdem.ErrHandComplete) {
+		t.Errorf("❌ Expected ErrHandComplete.  Actual: %v.", err)
+	}
+}
+
+func Test_Deal_RotatesButton(t *testing.T) {
+	table, players := newTable(t, holdem.Rules{SmallBlind: 5, BigBlind: 10}, 1000, 1000, 1000)
+
+	// Bob has no chips, and sits out.
+	players[1].Account.Balance = 0
+
+	for _, expected := range []string{"alice", "cara", "alice"} {
+		hand := deal(t, table)
+		if button, _ := table.Button(); button != expected {
+			t.Errorf("❌ Unexpected button.  Expected: %v.  Actual: %v.", expected, button)
+		}
+
+		if _, ok := hand.HoleCards("bob"); ok {
+			t.Errorf("❌ Bob should sit out without chips.")
+		}
+
+		// Heads up, the button posts the small blind and acts first.
+		player, _ := hand.ToAct()
+		act(t, hand, player, fold)
+	}
+}
+
+func Test_Deal_ReturnsError_WhenHandInProgress(t *testing.T) {
+	table, _ := newTable(t, holdem.Rules{SmallBlind: 5, BigBlind: 10}, 1000, 1000)
+	deal(t, table)
+
+	if _, err := table.Deal(); !errors.Is(err, holdem.ErrHandInProgress) {
+		t.Errorf("❌ Expected ErrHandInProgress.  Actual: %v.", err)
+	}
+}
+
+func Test_Hand_RejectsIllegalActions(t *testing.T) {
+	table, _ := newTable(t, holdem.Rules{SmallBlind: 5, BigBlind: 10}, 1000, 1000, 1000)
+	hand := deal(t, table)
+
+	var notYourTurn holdem.ErrNotYourTurn
+	if err := hand.Act("bob", call); !errors.As(err, &notYourTurn) {
+		t.Errorf("❌ Expected ErrNotYourTurn.  Actual: %v.", err)
+	}
+
+	illegal := []holdem.Action{
+		check,
+		{Kind: holdem.Bet, Amount: 20},
+		{Kind: holdem.Raise, Amount: 15},
+		{Kind: holdem.Raise, Amount: 1001},
+		{Kind: holdem.ActionKind(99)},
+	}
+
+	for _, action := range illegal {
+		var illegalAction holdem.ErrIllegalAction
+		if err := hand.Act("alice", action); !errors.As(err, &illegalAction) {
+			t.Errorf("❌ Expected ErrIllegalAction for %v.  Actual: %v.", action, err)
+		}
+	}
+
+	assertToAct(t, hand, "alice")
+	if hand.Pot() != 15 {
+		t.Errorf("❌ Illegal actions changed the pot.  Actual: %v.", hand.Pot())
+	}
+}
+
+func Test_Hand_EnforcesMinimumRaise(t *testing.T) {
+	table, _ := newTable(t, holdem.Rules{SmallBlind: 5, BigBlind: 10}, 1000, 1000, 1000)
+	hand := deal(t, table)
+
+	// Raising by 20 sets the minimum raise to 20.
+	act(t, hand, "alice", holdem.Action{Kind: holdem.Raise, Amount: 30})
+	if hand.MinRaiseTo() != 50 {
+		t.Errorf("❌ Unexpected minimum raise.  Expected: 50.  Actual: %v.", hand.MinRaiseTo())
+	}
+
+	if err := hand.Act("bob", holdem.Action{Kind: holdem.Raise, Amount: 40}); err == nil {
+		t.Errorf("❌ Expected raise to 40 to be rejected.")
+	}
+
+	act(t, hand, "bob", holdem.Action{Kind: holdem.Raise, Amount: 50})
+	act(t, hand, "cara", fold)
+	act(t, hand, "alice", call)
+
+	// Post-flop betting restarts, with the first player left of the button.
+	if hand.Stage() != holdem.Flop || len(hand.Board()) != 3 {
+		t.Fatalf("❌ Expected the flop.  Actual: %v, %v.", hand.Stage(), hand.Board())
+	}
+
+	assertToAct(t, hand, "bob")
+	if err := hand.Act("bob", holdem.Action{Kind: holdem.Bet, Amount: 5}); err == nil {
+		t.Errorf("❌ Expected a bet smaller than the big blind to be rejected.")
+	}
+}
+
+func Test_Hand_IncompleteAllInRaiseDoesNotReopenBetting(t *testing.T) {
+	table, _ := newTable(t, holdem.Rules{SmallBlind: 5, BigBlind: 10}, 1000, 1000, 45)
+	hand := deal(t, table)
+
+	act(t, hand, "alice", holdem.Action{Kind: holdem.Raise, Amount: 30})
+	act(t, hand, "bob", call)
+
+	// Cara is all in for 45.  A raise of 15 is less than the minimum of 20.
+	act(t, hand, "cara", holdem.Action{Kind: holdem.Raise, Amount: 45})
+
+	if err := hand.Act("alice", holdem.Action{Kind: holdem.Raise, Amount: 100}); err == nil {
+		t.Errorf("❌ Expected the betting to remain closed to alice.")
+	}
+
+	act(t, hand, "alice", call)
+	act(t, hand, "bob", call)
+
+	if hand.Stage() != holdem.Flop {
+		t.Errorf("❌ Expected the flop.  Actual: %v.", hand.Stage())
+	}
+}
+
+func Test_Hand_HeadsUpActionOrder(t *testing.T) {
+	table, players := newTable(t, holdem.Rules{SmallBlind: 5, BigBlind: 10}, 1000, 1000)
+	hand := deal(t, table)
+
+	// The button posts the small blind, and acts first before the flop only.
+	assertBalances(t, players, 995, 990)
+	act(t, hand, "alice", call)
+	act(t, hand, "bob", check)
+
+	assertToAct(t, hand, "bob")
+}
+
+func Test_Hand_ShowdownPaysBestHand(t *testing.T) {
+	table, players := newTable(t, holdem.Rules{SmallBlind: 5, BigBlind: 10, Ante: 2}, 1000, 1000, 1000)
+	hand := deal(t, table)
+
+	act(t, hand, "alice", call)
+	act(t, hand, "bob", call)
+	act(t, hand, "cara", check)
+
+	for _, stage := range []holdem.Stage{holdem.Flop, holdem.Turn, holdem.River} {
+		if hand.Stage() != stage {
+			t.Fatalf("❌ Unexpected stage.  Expected: %v.  Actual: %v.", stage, hand.Stage())
+		}
+
+		for _, player := range []string{"bob", "cara", "alice"} {
+			act(t, hand, player, check)
+		}
+	}
+
+	result, ok := hand.Result()
+	if !ok || len(result.Hands) != 3 || len(hand.Board()) != 5 {
+		t.Fatalf("❌ Expected a showdown.  Actual: %+v.", result)
+	}
+
+	assertWinners(t, hand, result, players, 3000)
+}
+
+func Test_Hand_RunsOutBoard_WhenAllIn(t *testing.T) {
+	table, players := newTable(t, holdem.Rules{SmallBlind: 5, BigBlind: 10}, 100, 1000)
+	hand := deal(t, table)
+
+	act(t, hand, "alice", holdem.Action{Kind: holdem.Raise, Amount: 100})
+	act(t, hand, "bob", call)
+
+	result, ok := hand.Result()
+	if !ok || len(hand.Board()) != 5 {
+		t.Fatalf("❌ Expected the board to be run out.  Actual: %v.", hand.Board())
+	}
+
+	assertWinners(t, hand, result, players, 1100)
+}
+
+func Test_Hand_ShortStackOnlyWinsMainPot(t *testing.T) {
+	table, players := newTable(t, holdem.Rules{SmallBlind: 5, BigBlind: 10}, 50, 1000, 1000)
+	hand := deal(t, table)
+
+	act(t, hand, "alice", holdem.Action{Kind: holdem.Raise, Amount: 50})
+	act(t, hand, "bob", holdem.Action{Kind: holdem.Raise, Amount: 200})
+	act(t, hand, "cara", call)
+
+	for hand.Stage() != holdem.Complete {
+		player, _ := hand.ToAct()
+		act(t, hand, player, check)
+	}
+
+	result, _ := hand.Result()
+	assertWinners(t, hand, result, players, 2050)
+
+	// Alice matched 50 from each player.
+	if result.Won["alice"] > 150 {
+		t.Errorf("❌ Alice won more than the main pot: %v.", result.Won["alice"])
+	}
+
+	if result.Won["bob"]+result.Won["cara"] < 300 {
+		t.Errorf("❌ Expected bob or cara to win the side pot.  Actual: %v.", result.Won)
+	}
+}
+
+func Test_Hand_RecordsBetsAndPayoutsInLedger(t *testing.T) {
+	table, players := newTable(t, holdem.Rules{SmallBlind: 5, BigBlind: 10}, 1000, 1000)
+	hand := deal(t, table)
+	act(t, hand, "alice", fold)
+
+	reference := hand.Reference()
+	if reference.Table != table.Name() || reference.Hand == "" {
+		t.Errorf("❌ Unexpected reference.  Expected: %v.  Actual: %+v.", table.Name(), reference)
+	}
+
+	// Two blinds, and bob's payout.
+	entries := table.House().EntriesForHand(reference)
+	if len(entries) != 3 || entries[2].Reason != house.ReasonPayout || entries[2].To != players[1].Account.ID {
+		t.Errorf("❌ Unexpected ledger entries.  Actual: %+v.", entries)
+	}
+
+	if err := table.House().Reconcile(players[0].Account, players[1].Account); err != nil {
+		t.Errorf("❌ Unexpected error.  Expected: Nil.  Actual: %v.", err)
+	}
+}
+
+func Test_Table_PlaysSideBySide_WithOwnHouses(t *testing.T) {
+	first, firstPlayers := newTable(t, holdem.Rules{SmallBlind: 5, BigBlind: 10}, 1000, 1000)
+	second, secondPlayers := newTable(t, holdem.Rules{SmallBlind: 50, BigBlind: 100}, 1000, 1000)
+
+	firstHand := deal(t, first)
+	secondHand := deal(t, second)
+
+	if firstHand.Pot() != 15 || secondHand.Pot() != 150 {
+		t.Fatalf("❌ Unexpected pots.  Expected: 15 and 150.  Actual: %v and %v.", firstHand.Pot(), secondHand.Pot())
+	}
+
+	act(t, firstHand, "alice", fold)
+	act(t, secondHand, "alice", fold)
+
+	assertBalances(t, firstPlayers, 995, 1005)
+	assertBalances(t, secondPlayers, 950, 1050)
+}
+
+func Test_Table_PlaysInterleavedHands_WithDefaultHouses(t *testing.T) {
+	players := func(names ...string) []holdem.Player {
+		var result []holdem.Player
+		for _, name := range names {
+			result = append(result, holdem.Player{Name: name, Account: &house.Account{Balance: 1000}})
+		}
+
+		return result
+	}
+
+	rules := holdem.Rules{SmallBlind: 5, BigBlind: 10}
+	firstPlayers := players("a", "b")
+	secondPlayers := players("c", "d", "e")
+	first, _ := holdem.NewTable(rules, firstPlayers)
+	second, _ := holdem.NewTable(rules, secondPlayers)
+
+	firstHand := deal(t, first)
+	secondHand := deal(t, second)
+
+	act(t, secondHand, "c", holdem.Action{Kind: holdem.Raise, Amount: 60})
+	act(t, firstHand, "a", fold)
+
+	// The first table's payout does not touch the second table's pot.
+	assertBalances(t, firstPlayers, 995, 1005)
+	if secondHand.Pot() != 75 || second.House().Contribution(secondPlayers[0].Account) != 60 {
+		t.Fatalf("❌ Unexpected second pot.  Expected: 75, with 60 from c.  Actual: %v.", secondHand.Pot())
+	}
+
+	act(t, secondHand, "d", fold)
+	act(t, secondHand, "e", fold)
+	assertBalances(t, secondPlayers, 1015, 995, 990)
+}
+
+func Test_Hand_RejectsAction_WhenHouseRefusesChips(t *testing.T) {
+	table, players := newTable(t, holdem.Rules{SmallBlind: 5, BigBlind: 10}, 1000, 1000, 1000)
+	hand := deal(t, table)
+	table.House().CloseAccount(players[0].Account)
+
+	if err := hand.Act("alice", call); err == nil {
+		t.Fatalf("❌ Expected the house to refuse alice's chips.")
+	}
+
+	// The hand is unchanged, and still matches the ledger.
+	assertToAct(t, hand, "alice")
+	if hand.Pot() != 15 {
+		t.Errorf("❌ Unexpected pot.  Expected: 15.  Actual: %v.", hand.Pot())
+	}
+
+	act(t, hand, "alice", fold)
+	act(t, hand, "bob", fold)
+	assertBalances(t, players, 0, 995, 1005)
+
+	if err := table.House().Reconcile(players[0].Account, players[1].Account, players[2].Account); err != nil {
+		t.Errorf("❌ Unexpected error.  Expected: Nil.  Actual: %v.", err)
+	}
+}
+
+func Test_Deal_ReturnsErrPotUnsettled_UntilPotIsPaid(t *testing.T) {
+	table, players := newTable(t, holdem.Rules{SmallBlind: 5, BigBlind: 10}, 1000, 1000, 1000)
+	bank := table.House()
+	cara := players[2].Account
+
+	// Closing cara through another house, once the pots are built, stands in for a concurrent close.
+	closer := house.New()
+	holdem.SetOddChipRule(t, func(seats ...*house.Account) house.OddChipRule {
+		return func(winners []*house.Account) []*house.Account {
+			closer.CloseAccount(cara)
+			return house.SeatOrder(seats...)(winners)
+		}
+	})
+
+	hand := deal(t, table)
+	act(t, hand, "alice", fold)
+	if err := hand.Act("bob", fold); !errors.Is(err, house.ErrAccountClosed) {
+		t.Fatalf("❌ Unexpected error.  Expected: %v.  Actual: %v.", house.ErrAccountClosed, err)
+	}
+
+	if _, err := table.Deal(); !errors.Is(err, holdem.ErrPotUnsettled) {
+		t.Fatalf("❌ Unexpected error.  Expected: %v.  Actual: %v.", holdem.ErrPotUnsettled, err)
+	}
+
+	// Cara is no longer eligible, so the pot stays in the house.
+	if err := hand.Settle(); err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if result, ok := hand.Result(); !ok || len(result.Won) != 0 {
+		t.Errorf("❌ Unexpected result.  Expected nothing won.  Actual: %v, %v.", result, ok)
+	}
+
+	if bank.Contribution(players[1].Account) != 0 || bank.PotBalance() != 15 {
+		t.Errorf("❌ Expected contributions cleared, with 15 left in the pot.  Actual: %v, %v.",
+			bank.Contribution(players[1].Account), bank.PotBalance())
+	}
+
+	// Bob posts the small blind and folds.  Alice wins the blinds, and the chips left in the pot.
+	next := deal(t, table)
+	act(t, next, "bob", fold)
+	assertBalances(t, players[:2], 1020, 990)
+}
+
+func Test_Deal_SkipsClosedAccounts(t *testing.T) {
+	table, players := newTable(t, holdem.Rules{SmallBlind: 5, BigBlind: 10}, 1000, 1000, 1000)
+	table.House().CloseAccount(players[1].Account)
+
+	hand := deal(t, table)
+
+	if _, ok := hand.HoleCards("bob"); ok {
+		t.Errorf("❌ Expected bob to sit out.")
+	}
+
+	if err := table.House().Reconcile(players[0].Account, players[1].Account, players[2].Account); err != nil {
+		t.Errorf("❌ Unexpected error.  Expected: Nil.  Actual: %v.", err)
+	}
+}
+
+func Test_NewTable_NamesTablesUniquely_WhenCalledConcurrently(t *testing.T) {
+	players := []holdem.Player{
+		{Name: "alice", Account: &house.Account{Balance: 100}},
+		{Name: "bob", Account: &house.Account{Balance: 100}},
+	}
+
+	var wg sync.WaitGroup
+	tables := make([]*holdem.Table, 20)
+	for i := range tables {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tables[i], _ = holdem.NewTable(holdem.Rules{SmallBlind: 5, BigBlind: 10}, players)
+		}()
+	}
+	wg.Wait()
+
+	names := make(map[string]bool)
+	for _, table := range tables {
+		names[table.Name()] = true
+	}
+
+	if len(names) != len(tables) {
+		t.Errorf("❌ Expected %v unique names.  Actual: %v.", len(tables), len(names))
+	}
+}
+
+func Test_NewTable_ReturnsError_WhenInvalid(t *testing.T) {
+	players := []holdem.Player{
+		{Name: "alice", Account: &house.Account{Balance: 100}},
+		{Name: "bob", Account: &house.Account{Balance: 100}},
+	}
+
+	testCases := []struct {
+		rules   holdem.Rules
+		players []holdem.Player
+	}{
+		{holdem.Rules{SmallBlind: 5, BigBlind: 0}, players},
+		{holdem.Rules{SmallBlind: 20, BigBlind: 10}, players},
+		{holdem.Rules{SmallBlind: 5, BigBlind: 10, Ante: -1}, players},
+		{holdem.Rules{SmallBlind: 5, BigBlind: 10}, players[:1]},
+		{holdem.Rules{SmallBlind: 5, BigBlind: 10}, []holdem.Player{players[0], players[0]}},
+		{holdem.Rules{SmallBlind: 5, BigBlind: 10}, []holdem.Player{players[0], {Name: "bob"}}},
+	}
+
+	for _, testCase := range testCases {
+		if _, err := holdem.NewTable(testCase.rules, testCase.players); err == nil {
+			t.Errorf("❌ Missing error for %+v.", testCase)
+		}
+	}
+}
+
+// Seats alice, bob, cara and so on, with the given balances.
+func newTable(t *testing.T, rules holdem.Rules, balances ...int) (*holdem.Table, []holdem.Player) {
+	t.Helper()
+
+	bank := house.New()
+	names := []string{"alice", "bob", "cara", "dave"}
+	players := make([]holdem.Player, len(balances))
+	for i, balance := range balances {
+		players[i] = holdem.Player{Name: names[i], Account: bank.NewAccount(names[i], balance)}
+	}
+
+	table, err := holdem.NewTable(rules, players, holdem.WithDeckOptions(deck.WithSeed(42)), holdem.WithHouse(bank))
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	return table, players
+}
+
+func deal(t *testing.T, table *holdem.Table) *holdem.Hand {
+	t.Helper()
+
+	hand, err := table.Deal()
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	return hand
+}
+
+func act(t *testing.T, hand *holdem.Hand, player string, action holdem.Action) {
+	t.Helper()
+
+	if err := hand.Act(player, action); err != nil {
+		t.Fatalf("❌ Unexpected error for %v %v: %v.", player, action, err)
+	}
+}
+
+func assertToAct(t *testing.T, hand *holdem.Hand, expected string) {
+	t.Helper()
+
+	if actual, _ := hand.ToAct(); actual != expected {
+		t.Errorf("❌ Unexpected player to act.  Expected: %v.  Actual: %v.", expected, actual)
+	}
+}
+
+func assertBalances(t *testing.T, players []holdem.Player, expected ...int) {
+	t.Helper()
+
+	for i, player := range players {
+		if player.Account.Balance != expected[i] {
+			t.Errorf("❌ Unexpected balance for %v.  Expected: %v.  Actual: %v.", player.Name, expected[i], player.Account.Balance)
+		}
+	}
+}
+
+// Checks the winners hold the best hands, and that no chips were created or lost.
+func assertWinners(t *testing.T, hand *holdem.Hand, result holdem.Result, players []holdem.Player, chips int) {
+	t.Helper()
+
+	var best int64
+	var expected []string
+	for _, player := range players {
+		hole, _ := hand.HoleCards(player.Name)
+		score := poker.BestHand(hand.Board().Append(hole, 2)).Score
+		switch {
+		case score > best:
+			best = score
+			expected = []string{player.Name}
+		case score == best:
+			expected = append(expected, player.Name)
+		}
+	}
+
+	slices.Sort(expected)
+	winners := slices.Sorted(slices.Values(result.Winners))
+	if !slices.Equal(winners, expected) {
+		t.Errorf("❌ Unexpected winners.  Expected: %v.  Actual: %v.", expected, result.Winners)
+	}
+
+	total := 0
+	for _, player := range players {
+		total += player.Account.Balance
+	}
+
+	if total != chips || hand.Pot() != 0 {
+		t.Errorf("❌ Unexpected chips.  Expected: %v, with an empty pot.  Actual: %v, with %v in the pot.", chips, total, hand.Pot())
+	}
+}
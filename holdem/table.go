@@ -0,0 +1,219 @@
+// Package holdem runs games of Texas hold'em.
+//
+// A Table seats players and moves the button.  Each call to Deal starts a Hand: a state machine that
+// posts antes and blinds, deals cards, runs the betting rounds and settles the pot.  Actions that
+// break the rules are rejected, and leave the hand unchanged.
+//
+// Money is held by a house.House.  Each hand moves chips through a house.Session, which tags its
+// bets and payouts with the hand's reference in the ledger.  As a house has a single pot, it can
+// only play one hand at a time, so every table has its own house.
+package holdem
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/David-Rushton/card-collection/deck"
+	"github.com/David-Rushton/card-collection/house"
+)
+
+const (
+	// The most players a table can seat.  Two hole cards each, plus a five card board, must not
+	// exhaust the deck.
+	MaxPlayers = 10
+)
+
+var (
+	// The number of tables created.  Used to name tables.
+	tablesOpened atomic.Int64
+)
+
+// The stakes.
+type Rules struct {
+	SmallBlind int
+	BigBlind   int
+
+	// Paid by every player, before the cards are dealt.  Zero for no ante.
+	Ante int
+}
+
+// A seat at the table.
+type Player struct {
+	Name    string
+	Account *house.Account
+}
+
+// Seats players, and deals hands.
+type Table struct {
+	// Identifies the table in the house ledger.
+	name string
+
+	rules   Rules
+	players []Player
+	deck    *deck.Deck
+	house   *house.House
+
+	// The seat holding the button.  -1 before the first hand.
+	button int
+	hand   *Hand
+
+	// The number of hands dealt.
+	hands int
+}
+
+// Configures a table.
+type Option func(*Table)
+
+// Deals from a deck created with options.  Use deck.WithSeed for repeatable games.
+func WithDeckOptions(options ...deck.Option) Option {
+	return func(t *Table) {
+		t.deck = deck.New(options...)
+	}
+}
+
+// Holds the table's money in a house.  Open the players' accounts with the same house, so the
+// ledger holds their deposits and can be reconciled.  The house must not be shared with another
+// table.
+//
+// Without this option each table creates its own house, with house.New.  That house's ledger only
+// holds the table's bets and payouts.  See Table.House.
+func WithHouse(h *house.House) Option {
+	return func(t *Table) {
+		t.house = h
+	}
+}
+
+// Names the table.  Bets and payouts are recorded in the house ledger against the table's name.
+// Tables are named table-1, table-2 and so on by default.
+func WithName(name string) Option {
+	return func(t *Table) {
+		t.name = name
+	}
+}
+
+// Returns a table, with players seated in order.
+//
+// Returns ErrInvalidRules when the blinds or ante are invalid, ErrNotEnoughPlayers or
+// ErrTooManyPlayers when the table cannot seat the players, and ErrInvalidPlayer when a name is
+// repeated or an account is missing.
+func NewTable(rules Rules, players []Player, options ...Option) (*Table, error) {
+	if rules.BigBlind <= 0 || rules.SmallBlind < 0 || rules.Ante < 0 || rules.SmallBlind > rules.BigBlind || rules.Ante > rules.BigBlind {
+		return nil, ErrInvalidRules
+	}
+
+	if len(players) < 2 {
+		return nil, ErrNotEnoughPlayers
+	}
+
+	if len(players) > MaxPlayers {
+		return nil, ErrTooManyPlayers{Max: MaxPlayers, Actual: len(players)}
+	}
+
+	names := make(map[string]bool)
+	for _, player := range players {
+		if player.Account == nil {
+			return nil, ErrInvalidPlayer{Name: player.Name, Reason: "Missing account"}
+		}
+
+		if names[player.Name] {
+			return nil, ErrInvalidPlayer{Name: player.Name, Reason: "Name is already taken"}
+		}
+
+		names[player.Name] = true
+	}
+
+	table := &Table{
+		name:    fmt.Sprintf("table-%d", tablesOpened.Add(1)),
+		rules:   rules,
+		players: append([]Player{}, players...),
+		deck:    deck.New(),
+		house:   house.New(),
+		button:  -1,
+	}
+
+	for _, option := range options {
+		option(table)
+	}
+
+	return table, nil
+}
+
+// Returns the table's name.
+func (t *Table) Name() string {
+	return t.name
+}
+
+// Returns the house holding the table's money.
+func (t *Table) House() *house.House {
+	return t.house
+}
+
+// Returns the player holding the button.
+// Returns false before the first hand.
+func (t *Table) Button() (string, bool) {
+	if t.button < 0 {
+		return "", false
+	}
+
+	return t.players[t.button].Name, true
+}
+
+// Moves the button, and starts a new hand.
+// Players without chips, or with closed accounts, sit out.  The hand's bets and payouts are tagged
+// with its reference in the house ledger.  See Hand.Reference.
+//
+// Returns ErrHandInProgress when the previous hand is not complete, ErrPotUnsettled when its pot
+// has not been paid, and ErrNotEnoughPlayers when fewer than two players can play.  Returns the
+// house's error when the hand ends before anyone acts, and cannot be paid out.
+func (t *Table) Deal() (*Hand, error) {
+	if t.hand != nil && t.hand.Stage() != Complete {
+		return nil, ErrHandInProgress
+	}
+
+	if t.hand != nil && t.hand.result == nil {
+		return nil, ErrPotUnsettled
+	}
+
+	var seated []Player
+	for _, player := range t.players {
+		if canPlay(player) {
+			seated = append(seated, player)
+		}
+	}
+
+	if len(seated) < 2 {
+		return nil, ErrNotEnoughPlayers
+	}
+
+	// The button moves to the next player who can play.
+	for {
+		t.button = (t.button + 1) % len(t.players)
+		if canPlay(t.players[t.button]) {
+			break
+		}
+	}
+
+	var button int
+	for i, player := range seated {
+		if player.Name == t.players[t.button].Name {
+			button = i
+		}
+	}
+
+	t.hands++
+	session := t.house.Session(house.Reference{Table: t.name, Hand: fmt.Sprintf("hand-%d", t.hands)})
+
+	t.deck.Shuffle()
+	hand, err := newHand(t.rules, seated, button, t.deck, t.house, session)
+	t.hand = hand
+	if err != nil {
+		return nil, err
+	}
+
+	return t.hand, nil
+}
+
+// True when the player has chips, and an open account.
+func canPlay(player Player) bool {
+	return !player.Account.Closed() && player.Account.Available() > 0
+}
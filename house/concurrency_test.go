@@ -0,0 +1,108 @@
+package house_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/David-Rushton/card-collection/house"
+)
+
+// Run with -race to check the house's locking.
+
+func Test_Bet_NeverOverdrawsAccount_WhenCalledConcurrently(t *testing.T) {
+	bank := house.New()
+	account := bank.NewAccount("alice", 100)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	accepted := 0
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			// Only ten bets can be afforded.
+			if err := bank.Bet(account, 10); err == nil {
+				mu.Lock()
+				accepted++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if accepted != 10 || account.Available() != 0 || bank.PotBalance() != 100 {
+		t.Errorf("❌ Unexpected bets.  Expected: 10 accepted, leaving 0 with 100 in the pot.  Actual: %v accepted, leaving %v with %v in the pot.", accepted, account.Available(), bank.PotBalance())
+	}
+
+	if err := bank.Reconcile(account); err != nil {
+		t.Errorf("❌ Unexpected error.  Expected: Nil.  Actual: %v.", err)
+	}
+}
+
+func Test_BetAndPayout_ConserveMoney_WhenCalledConcurrently(t *testing.T) {
+	bank := house.New()
+	accounts := make([]*house.Account, 8)
+	for i := range accounts {
+		accounts[i] = bank.NewAccount("player", 1000)
+	}
+
+	var wg sync.WaitGroup
+	for i, account := range accounts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for j := range 200 {
+				bank.Bet(account, j%7+1)
+				if j%10 == 0 {
+					bank.Payout(accounts[(i+j)%len(accounts)], account)
+				}
+
+				bank.Pots()
+				account.Available()
+			}
+		}()
+	}
+	wg.Wait()
+
+	total := bank.PotBalance()
+	for _, account := range accounts {
+		if account.Available() < 0 {
+			t.Errorf("❌ Account %v is overdrawn: %v.", account.ID, account.Available())
+		}
+
+		total += account.Available()
+	}
+
+	if total != 8000 {
+		t.Errorf("❌ Unexpected total.  Expected: 8000.  Actual: %v.", total)
+	}
+
+	if err := bank.Reconcile(accounts...); err != nil {
+		t.Errorf("❌ Unexpected error.  Expected: Nil.  Actual: %v.", err)
+	}
+}
+
+func Test_Bet_IsSafe_WhenAccountSharedByHouses(t *testing.T) {
+	first := house.New()
+	second := house.New()
+	account := &house.Account{Balance: 1000}
+
+	var wg sync.WaitGroup
+	for _, bank := range []*house.House{first, second} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for range 1000 {
+				bank.Bet(account, 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if account.Available() != 0 || first.PotBalance()+second.PotBalance() != 1000 {
+		t.Errorf("❌ Unexpected balances.  Expected: 0, with 1000 in the pots.  Actual: %v, with %v and %v in the pots.", account.Available(), first.PotBalance(), second.PotBalance())
+	}
+}
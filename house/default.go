@@ -1,47 +1,134 @@
 // The place that always wins.
 // Manages money.
+//
+// Money is held by a House.  The package functions use a default house, shared by the whole
+// process.  Create a House with New for each table that plays at the same time.
 package house
 
+import (
+	"sync"
+)
+
 var (
-	// The house account.
-	// All bets are paid into the pot.
-	// All winnings are paid out of the pot.
-	pot = &Account{}
+	// Used by the package functions.
+	defaultHouse = New()
 )
 
 // Tracks a users balance with the house.
+//
+// Create accounts with NewAccount, so they are identified in the ledger and their opening balance
+// is recorded.  Use Available to read the balance while other goroutines are betting.
 type Account struct {
+	ID      AccountID
+	Owner   string
 	Balance int
+
+	// Guards Balance and closed while money is moved.
+	mu     sync.Mutex
+	closed bool
+}
+
+// Returns the balance.  Safe for concurrent use.
+func (a *Account) Available() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.Balance
+}
+
+// Returns the house used by the package functions.
+func Default() *House {
+	return defaultHouse
+}
+
+// Returns true when the account has been closed.  See House.CloseAccount.
+func (a *Account) Closed() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.closed
 }
 
-// Returns the size of the pot.
+// Returns the size of the default house's pot.
+// See House.PotBalance.
 func PotBalance() int {
-	return pot.Balance
+	return defaultHouse.PotBalance()
 }
 
-// Place your bets!
-// Moves money from an account into the pot.
+// Moves money from an account into the default house's pot.
+// See House.Bet.
 func Bet(account *Account, amount int) error {
-	return transfer(account, pot, amount)
+	return defaultHouse.Bet(account, amount)
+}
+
+// Shares the default house's pot equally between all players.
+// See House.Payout.
+func Payout(accounts ...*Account) error {
+	return defaultHouse.Payout(accounts...)
+}
+
+// Pays the default house's pot according to a plan.
+// See House.PayoutWith.
+func PayoutWith(plan PayoutPlan) (PayoutReport, error) {
+	return defaultHouse.PayoutWith(plan)
+}
+
+// Returns the chips an account has bet in the default house, since the last payout.
+// See House.Contribution.
+func Contribution(account *Account) int {
+	return defaultHouse.Contribution(account)
+}
+
+// Gives up the account's claim on the default house's pot.
+// See House.Forfeit.
+func Forfeit(account *Account) {
+	defaultHouse.Forfeit(account)
+}
+
+// Divides the default house's pot into a main pot, followed by any side pots.
+// See House.Pots.
+func Pots() []Pot {
+	return defaultHouse.Pots()
 }
 
-// Pot is shared equally between all players.
-// If the pot cannot be split evenly the odd remains, to be won in later hands.
-func Payout(accounts ...*Account) {
-	share := pot.Balance / len(accounts)
+// Pays each of the default house's pots to the best eligible accounts.
+// See House.PayoutPots.
+func PayoutPots(ranking [][]*Account, oddChips OddChipRule) ([]Award, error) {
+	return defaultHouse.PayoutPots(ranking, oddChips)
+}
+
+// Returns a new account with the default house.
+// See House.NewAccount.
+func NewAccount(owner string, balance int) *Account {
+	return defaultHouse.NewAccount(owner, balance)
+}
 
-	for _, account := range accounts {
-		transfer(pot, account, share)
-	}
+// Closes an account with the default house.
+// See House.CloseAccount.
+func CloseAccount(account *Account) (int, error) {
+	return defaultHouse.CloseAccount(account)
 }
 
-func transfer(from, to *Account, amount int) error {
-	if from.Balance < amount {
-		return ErrInsufficientFunds
-	}
+// Returns every entry in the default house's ledger.
+// See House.Ledger.
+func Ledger() []Entry {
+	return defaultHouse.Ledger()
+}
 
-	from.Balance -= amount
-	to.Balance += amount
+// Returns the default house's entries for an account.
+// See House.EntriesForAccount.
+func EntriesForAccount(id AccountID) []Entry {
+	return defaultHouse.EntriesForAccount(id)
+}
+
+// Returns the default house's entries for a hand.
+// See House.EntriesForHand.
+func EntriesForHand(ref Reference) []Entry {
+	return defaultHouse.EntriesForHand(ref)
+}
 
-	return nil
+// Checks the default house's pot, and each account, against its ledger.
+// See House.Reconcile.
+func Reconcile(accounts ...*Account) error {
+	return defaultHouse.Reconcile(accounts...)
 }
@@ -2,9 +2,45 @@ package house
 
 import (
 	"errors"
+	"fmt"
 )
 
 var (
 	// Returned if a player tries to bet any amount that is greater than their balance.
 	ErrInsufficientFunds = errors.New("cannot place bet, due to insufficient funds")
+
+	// Returned when betting zero or less.
+	ErrInvalidAmount = errors.New("amount must be greater than zero")
+
+	// Returned when paying out the pot to nobody.
+	ErrNoRecipients = errors.New("payout requires at least one account")
+
+	// Returned when moving money in or out of a closed account.
+	ErrAccountClosed = errors.New("account is closed")
+
+	// Returned when reconciling an account created without NewAccount.
+	ErrUnidentifiedAccount = errors.New("account has no ID, so cannot be found in the ledger")
 )
+
+// Returned when an account's balance does not match its ledger entries.
+type ErrUnreconciled struct {
+	Account AccountID
+	Balance int
+
+	// The balance according to the ledger.
+	Ledger int
+}
+
+func (e ErrUnreconciled) Error() string {
+	return fmt.Sprintf("Account %v has a balance of %d, but the ledger shows %d.", e.Account, e.Balance, e.Ledger)
+}
+
+// Returned when a payout plan's fixed amounts are more than the pot holds.
+type ErrPotTooSmall struct {
+	Required  int
+	Available int
+}
+
+func (e ErrPotTooSmall) Error() string {
+	return fmt.Sprintf("Expected a pot of at least %d but found %d.", e.Required, e.Available)
+}
@@ -0,0 +1,233 @@
+package house
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+var (
+	// Held while locking more than one account.  Only one goroutine at a time can wait for a second
+	// account, so payouts cannot deadlock.
+	multiLock sync.Mutex
+)
+
+// Holds the money for a game.  Each house has its own pot, accounts and ledger, so several tables
+// can play at once.  A house can only run one hand at a time.
+//
+// Use New to create a house.  The package functions use a default house.
+//
+// A house is safe for concurrent use.  Each operation completes before the next starts, so money is
+// never created or lost.
+type House struct {
+	// Guards everything below, including the pot's balance.
+	mu sync.Mutex
+
+	// All bets are paid into the pot.
+	// All winnings are paid out of the pot.
+	pot *Account
+
+	// Chips bet by each account, since the last payout.
+	contributions map[*Account]int
+
+	// Accounts in the order they first bet.  Keeps results repeatable.
+	contributors []*Account
+
+	// Accounts that have given up their claim on the pot.
+	forfeited map[*Account]bool
+
+	ledger []Entry
+
+	// The number of accounts opened.  Used to create IDs.
+	accountsOpened int
+
+	// Returns the time recorded against ledger entries.
+	now func() time.Time
+}
+
+// Configures a house.
+type Option func(*House)
+
+// Uses a clock to timestamp ledger entries.  Use for repeatable ledgers.
+func WithClock(now func() time.Time) Option {
+	return func(h *House) {
+		h.now = now
+	}
+}
+
+// Returns an empty house.
+func New(options ...Option) *House {
+	h := &House{
+		pot: &Account{ID: PotID, Owner: "house"},
+		now: time.Now,
+	}
+
+	h.resetContributions()
+
+	for _, option := range options {
+		option(h)
+	}
+
+	return h
+}
+
+// Returns the size of the pot.
+func (h *House) PotBalance() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.pot.Balance
+}
+
+// Place your bets!
+// Moves money from an account into the pot.
+// The bet is recorded against the account, for building side pots.  See Pots.
+//
+// Returns ErrInvalidAmount when the amount is zero or less, ErrAccountClosed when the account is
+// closed, and ErrInsufficientFunds when the balance is too small.  Nothing moves when an error is
+// returned.
+func (h *House) Bet(account *Account, amount int) error {
+	return h.bet(Reference{}, account, amount)
+}
+
+// Moves money into the pot, tagging the ledger entry with a reference.
+func (h *House) bet(ref Reference, account *Account, amount int) error {
+	if amount <= 0 {
+		return ErrInvalidAmount
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if err := h.transfer(account, h.pot, amount, ReasonBet, ref); err != nil {
+		return err
+	}
+
+	h.contribute(account, amount)
+	return nil
+}
+
+// Pot is shared equally between all players.
+// If the pot cannot be split evenly the odd remains, to be won in later hands.
+// Use PayoutPots when players are all in for different amounts.
+//
+// Returns ErrNoRecipients when there are no accounts, and ErrAccountClosed when any account is
+// closed.  Accounts are checked before any money moves.
+func (h *House) Payout(accounts ...*Account) error {
+	return h.payout(Reference{}, accounts...)
+}
+
+// Shares the pot equally, tagging the ledger entries with a reference.
+func (h *House) payout(ref Reference, accounts ...*Account) error {
+	if len(accounts) == 0 {
+		return ErrNoRecipients
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	share := h.pot.Balance / len(accounts)
+	amounts := make([]int, len(accounts))
+	for i := range amounts {
+		amounts[i] = share
+	}
+
+	if err := h.payAll(accounts, amounts, ref); err != nil {
+		return err
+	}
+
+	h.resetContributions()
+
+	return nil
+}
+
+// Moves money between accounts, and records it in the ledger.
+// Both balances change together, or neither does.
+//
+// Callers must hold the house's lock.  The lock guards the pot, so only the other account is locked.
+func (h *House) transfer(from, to *Account, amount int, reason Reason, ref Reference) error {
+	account := from
+	if from == h.pot {
+		account = to
+	}
+
+	account.mu.Lock()
+	defer account.mu.Unlock()
+
+	switch {
+	case amount < 0:
+		return ErrInvalidAmount
+	case from.closed || to.closed:
+		return ErrAccountClosed
+	case from.Balance < amount:
+		return ErrInsufficientFunds
+	}
+
+	from.Balance -= amount
+	to.Balance += amount
+	h.record(from.ID, to.ID, amount, reason, ref)
+
+	return nil
+}
+
+// Pays each account its amount from the pot.  Every payment is checked before any money moves, so
+// all are paid or none are.
+//
+// Returns ErrInvalidAmount when an amount is negative, ErrAccountClosed when an account is closed,
+// and ErrInsufficientFunds when the pot cannot cover the total.  Callers must hold the house's lock.
+func (h *House) payAll(accounts []*Account, amounts []int, ref Reference) error {
+	unlock := lockAccounts(accounts)
+	defer unlock()
+
+	total := 0
+	for i, account := range accounts {
+		switch {
+		case amounts[i] < 0:
+			return ErrInvalidAmount
+		case account.closed:
+			return ErrAccountClosed
+		}
+
+		total += amounts[i]
+	}
+
+	if total > h.pot.Balance {
+		return ErrInsufficientFunds
+	}
+
+	for i, account := range accounts {
+		h.pot.Balance -= amounts[i]
+		account.Balance += amounts[i]
+		h.record(h.pot.ID, account.ID, amounts[i], ReasonPayout, ref)
+	}
+
+	return nil
+}
+
+// Locks each account once, and returns a function that unlocks them.
+func lockAccounts(accounts []*Account) func() {
+	var unique []*Account
+	for _, account := range accounts {
+		if !slices.Contains(unique, account) {
+			unique = append(unique, account)
+		}
+	}
+
+	if len(unique) > 1 {
+		multiLock.Lock()
+	}
+
+	for _, account := range unique {
+		account.mu.Lock()
+	}
+
+	return func() {
+		for _, account := range unique {
+			account.mu.Unlock()
+		}
+
+		if len(unique) > 1 {
+			multiLock.Unlock()
+		}
+	}
+}
@@ -1,7 +1,9 @@
 package house_test
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/David-Rushton/card-collection/house"
 )
@@ -70,6 +72,66 @@ func Test_Bet_ReturnsNilErr_WhenFundsAvailable(t *testing.T) {
 	}
 }
 
+func Test_Bet_ReturnsErrInvalidAmount_WhenAmountNotPositive(t *testing.T) {
+	t.Cleanup(cleanup)
+
+	for _, amount := range []int{0, -500} {
+		account := &house.Account{Balance: 100}
+		err := house.Bet(account, amount)
+
+		if err != house.ErrInvalidAmount || account.Balance != 100 || house.PotBalance() != 0 {
+			t.Errorf("❌ Unexpected result for %v.  Expected: ErrInvalidAmount, with nothing moved.  Actual: %v, with a balance of %v.", amount, err, account.Balance)
+		}
+	}
+}
+
+func Test_Bet_ReturnsErrAccountClosed_WhenAccountClosed(t *testing.T) {
+	bank := house.New()
+	account := bank.NewAccount("alice", 100)
+
+	withdrawn, err := bank.CloseAccount(account)
+	if err != nil || withdrawn != 100 || account.Balance != 0 || !account.Closed() {
+		t.Fatalf("❌ Unexpected close.  Expected: 100 withdrawn.  Actual: %v withdrawn, error %v.", withdrawn, err)
+	}
+
+	if err := bank.Bet(account, 10); err != house.ErrAccountClosed {
+		t.Errorf("❌ Unexpected error.  Expected: ErrAccountClosed.  Actual: %v.", err)
+	}
+
+	if _, err := bank.CloseAccount(account); err != house.ErrAccountClosed {
+		t.Errorf("❌ Unexpected error.  Expected: ErrAccountClosed.  Actual: %v.", err)
+	}
+
+	if err := bank.Reconcile(account); err != nil {
+		t.Errorf("❌ Unexpected error.  Expected: Nil.  Actual: %v.", err)
+	}
+}
+
+func Test_Payout_ReturnsErrNoRecipients_WhenNoAccounts(t *testing.T) {
+	t.Cleanup(cleanup)
+
+	house.Bet(&house.Account{Balance: 10}, 10)
+
+	if err := house.Payout(); err != house.ErrNoRecipients || house.PotBalance() != 10 {
+		t.Errorf("❌ Unexpected result.  Expected: ErrNoRecipients, with 10 in the pot.  Actual: %v, with %v in the pot.", err, house.PotBalance())
+	}
+}
+
+func Test_Payout_ReturnsErrAccountClosed_WhenAnyAccountClosed(t *testing.T) {
+	bank := house.New()
+	alice := bank.NewAccount("alice", 100)
+	bob := bank.NewAccount("bob", 100)
+	bank.Bet(alice, 10)
+	bank.Bet(bob, 10)
+	bank.CloseAccount(bob)
+
+	err := bank.Payout(alice, bob)
+
+	if !errors.Is(err, house.ErrAccountClosed) || alice.Balance != 90 || bank.PotBalance() != 20 {
+		t.Errorf("❌ Unexpected result.  Expected: ErrAccountClosed, with nothing paid.  Actual: %v, with %v in the pot.", err, bank.PotBalance())
+	}
+}
+
 func Test_Payout_ReturnsZeroPot_WhenPotDividesEqually(t *testing.T) {
 	t.Cleanup(cleanup)
 
@@ -136,6 +198,38 @@ func Test_Payout_CreditsAccount(t *testing.T) {
 
 }
 
+func Test_New_HousesHaveSeparatePots(t *testing.T) {
+	first := house.New()
+	second := house.New()
+	alice := first.NewAccount("alice", 100)
+	bob := second.NewAccount("bob", 100)
+
+	first.Bet(alice, 10)
+	second.Bet(bob, 20)
+
+	if first.PotBalance() != 10 || second.PotBalance() != 20 || house.PotBalance() != 0 {
+		t.Errorf("❌ Unexpected pots.  Expected: 10, 20 and 0.  Actual: %v, %v and %v.", first.PotBalance(), second.PotBalance(), house.PotBalance())
+	}
+
+	second.Payout(bob)
+
+	if first.PotBalance() != 10 || len(first.Pots()) != 1 || bob.Balance != 100 {
+		t.Errorf("❌ Expected a payout from one house to leave the other unchanged.")
+	}
+}
+
+func Test_WithClock_TimestampsEntries(t *testing.T) {
+	expected := time.Date(2024, time.March, 1, 20, 0, 0, 0, time.UTC)
+	bank := house.New(house.WithClock(func() time.Time { return expected }))
+
+	bank.NewAccount("alice", 100)
+
+	entries := bank.Ledger()
+	if len(entries) != 1 || !entries[0].Time.Equal(expected) {
+		t.Errorf("❌ Unexpected entries.  Expected one at %v.  Actual: %+v.", expected, entries)
+	}
+}
+
 func cleanup() {
 	// Paying out to one account will always clear the entire pot.
 	house.Payout(&house.Account{})
@@ -0,0 +1,192 @@
+package house
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Every movement of money is recorded in the ledger.  Entries are never changed or removed, so the
+// ledger can be used to audit accounts and resolve disputes.
+
+// Identifies an account.
+type AccountID string
+
+const (
+	// The house pot.
+	PotID AccountID = "pot"
+
+	// The source of opening balances.  Money enters the house from the cashier.
+	CashierID AccountID = "cashier"
+)
+
+// Why money moved.
+type Reason string
+
+const (
+	ReasonDeposit    Reason = "deposit"
+	ReasonWithdrawal Reason = "withdrawal"
+	ReasonBet        Reason = "bet"
+	ReasonPayout     Reason = "payout"
+)
+
+// Identifies the game a transaction belongs to.  See Session.
+type Reference struct {
+	Table string
+	Hand  string
+}
+
+// A single movement of money.
+type Entry struct {
+	// Entries are numbered in the order they were recorded, from 1.
+	ID     uint64
+	Time   time.Time
+	From   AccountID
+	To     AccountID
+	Amount int
+	Reason Reason
+
+	// The game that was being played.  Empty outside of a game.
+	Reference Reference
+}
+
+// Returns a new account, with an ID, for an owner.
+// The opening balance is recorded as a deposit from the cashier.
+func (h *House) NewAccount(owner string, balance int) *Account {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.accountsOpened++
+	account := &Account{
+		ID:      AccountID(fmt.Sprintf("acct-%d", h.accountsOpened)),
+		Owner:   owner,
+		Balance: balance,
+	}
+
+	h.record(CashierID, account.ID, balance, ReasonDeposit, Reference{})
+
+	return account
+}
+
+// Closes an account, and returns its balance to the cashier.
+// Closed accounts cannot bet, be paid or win a pot.  Chips already bet stay in the pot.
+//
+// Returns the amount withdrawn, or ErrAccountClosed when the account is already closed.
+func (h *House) CloseAccount(account *Account) (int, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	account.mu.Lock()
+	defer account.mu.Unlock()
+
+	if account.closed {
+		return 0, ErrAccountClosed
+	}
+
+	amount := account.Balance
+	account.Balance = 0
+	account.closed = true
+	h.record(account.ID, CashierID, amount, ReasonWithdrawal, Reference{})
+
+	return amount, nil
+}
+
+// Returns every ledger entry, oldest first.
+func (h *House) Ledger() []Entry {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return append([]Entry{}, h.ledger...)
+}
+
+// Returns the entries that moved money in or out of an account, oldest first.
+func (h *House) EntriesForAccount(id AccountID) []Entry {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var result []Entry
+	for _, entry := range h.ledger {
+		if entry.From == id || entry.To == id {
+			result = append(result, entry)
+		}
+	}
+
+	return result
+}
+
+// Returns the entries recorded during a hand, oldest first.
+func (h *House) EntriesForHand(ref Reference) []Entry {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var result []Entry
+	for _, entry := range h.ledger {
+		if entry.Reference == ref {
+			result = append(result, entry)
+		}
+	}
+
+	return result
+}
+
+// Checks that the pot, and each account, has the balance shown by its ledger entries.
+//
+// Returns ErrUnidentifiedAccount for accounts without an ID, and ErrUnreconciled for each balance
+// that does not match.  Multiple errors are joined.
+func (h *House) Reconcile(accounts ...*Account) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var errs []error
+	for _, account := range append([]*Account{h.pot}, accounts...) {
+		if account.ID == "" {
+			errs = append(errs, ErrUnidentifiedAccount)
+			continue
+		}
+
+		balance := account.Balance
+		if account != h.pot {
+			balance = account.Available()
+		}
+
+		if expected := h.ledgerBalance(account.ID); expected != balance {
+			errs = append(errs, ErrUnreconciled{Account: account.ID, Balance: balance, Ledger: expected})
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Returns the sum of the entries for an account.
+func (h *House) ledgerBalance(id AccountID) int {
+	var balance int
+	for _, entry := range h.ledger {
+		if entry.To == id {
+			balance += entry.Amount
+		}
+
+		if entry.From == id {
+			balance -= entry.Amount
+		}
+	}
+
+	return balance
+}
+
+// Adds an entry to the ledger.  Nothing is recorded when no money moves.
+// Callers must hold the house's lock.
+func (h *House) record(from, to AccountID, amount int, reason Reason, ref Reference) {
+	if amount == 0 {
+		return
+	}
+
+	h.ledger = append(h.ledger, Entry{
+		ID:        uint64(len(h.ledger) + 1),
+		Time:      h.now(),
+		From:      from,
+		To:        to,
+		Amount:    amount,
+		Reason:    reason,
+		Reference: ref,
+	})
+}
@@ -0,0 +1,113 @@
+package house_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/David-Rushton/card-collection/house"
+)
+
+func Test_NewAccount_RecordsOpeningDeposit(t *testing.T) {
+	bank := house.New()
+
+	alice := bank.NewAccount("alice", 100)
+	bob := bank.NewAccount("bob", 100)
+
+	if alice.ID == "" || alice.ID == bob.ID || alice.Owner != "alice" {
+		t.Errorf("❌ Unexpected accounts.  Actual: %+v and %+v.", alice, bob)
+	}
+
+	entries := bank.EntriesForAccount(alice.ID)
+	expected := house.Entry{From: house.CashierID, To: alice.ID, Amount: 100, Reason: house.ReasonDeposit}
+	if len(entries) != 1 || !sameTransfer(entries[0], expected) {
+		t.Errorf("❌ Unexpected entries.  Expected: %+v.  Actual: %+v.", expected, entries)
+	}
+}
+
+func Test_Ledger_RecordsBetsAndPayouts(t *testing.T) {
+	bank := house.New()
+
+	alice := bank.NewAccount("alice", 100)
+	bob := bank.NewAccount("bob", 100)
+	reference := house.Reference{Table: "ledger", Hand: "1"}
+
+	session := bank.Session(reference)
+	session.Bet(alice, 10)
+	session.Bet(bob, 10)
+	session.Payout(bob)
+
+	// Transactions outside the session are not tagged.
+	bank.Bet(alice, 5)
+	bank.Payout(alice)
+
+	expected := []house.Entry{
+		{From: alice.ID, To: house.PotID, Amount: 10, Reason: house.ReasonBet, Reference: reference},
+		{From: bob.ID, To: house.PotID, Amount: 10, Reason: house.ReasonBet, Reference: reference},
+		{From: house.PotID, To: bob.ID, Amount: 20, Reason: house.ReasonPayout, Reference: reference},
+	}
+
+	actual := bank.EntriesForHand(reference)
+	if len(actual) != len(expected) {
+		t.Fatalf("❌ Unexpected entries.  Expected: %+v.  Actual: %+v.", expected, actual)
+	}
+
+	for i := range expected {
+		if !sameTransfer(actual[i], expected[i]) {
+			t.Errorf("❌ Unexpected entry.  Expected: %+v.  Actual: %+v.", expected[i], actual[i])
+		}
+
+		if i > 0 && actual[i].ID <= actual[i-1].ID {
+			t.Errorf("❌ Expected entry IDs to increase.  Actual: %v then %v.", actual[i-1].ID, actual[i].ID)
+		}
+	}
+
+	if len(bank.EntriesForAccount(bob.ID)) != 3 {
+		t.Errorf("❌ Expected bob's deposit, bet and payout.  Actual: %+v.", bank.EntriesForAccount(bob.ID))
+	}
+}
+
+func Test_Ledger_ReturnsCopy(t *testing.T) {
+	bank := house.New()
+
+	alice := bank.NewAccount("alice", 100)
+	entries := bank.Ledger()
+	entries[len(entries)-1].Amount = 1
+
+	if err := bank.Reconcile(alice); err != nil {
+		t.Errorf("❌ Unexpected error.  Expected: Nil.  Actual: %v.", err)
+	}
+}
+
+func Test_Reconcile_ReturnsError_WhenBalanceDoesNotMatch(t *testing.T) {
+	bank := house.New()
+
+	alice := bank.NewAccount("alice", 100)
+	bank.Bet(alice, 40)
+
+	if err := bank.Reconcile(alice); err != nil {
+		t.Fatalf("❌ Unexpected error.  Expected: Nil.  Actual: %v.", err)
+	}
+
+	alice.Balance += 5
+
+	var unreconciled house.ErrUnreconciled
+	err := bank.Reconcile(alice)
+	if !errors.As(err, &unreconciled) || unreconciled.Balance != 65 || unreconciled.Ledger != 60 {
+		t.Errorf("❌ Unexpected error.  Expected: ErrUnreconciled.  Actual: %v.", err)
+	}
+}
+
+func Test_Reconcile_ReturnsError_WhenAccountUnidentified(t *testing.T) {
+	bank := house.New()
+
+	err := bank.Reconcile(&house.Account{Balance: 10})
+	if !errors.Is(err, house.ErrUnidentifiedAccount) {
+		t.Errorf("❌ Unexpected error.  Expected: ErrUnidentifiedAccount.  Actual: %v.", err)
+	}
+}
+
+// Compares entries, ignoring their ID and time.
+func sameTransfer(a, b house.Entry) bool {
+	a.ID, a.Time = b.ID, b.Time
+	return a == b
+}
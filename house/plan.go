@@ -0,0 +1,148 @@
+package house
+
+// Payout plans.
+//
+// A plan divides the pot by shares, rather than equally.  Each share is a fixed amount, a weight, or
+// both.  Fixed amounts are paid first, such as 3:2 on a blackjack.  What remains is divided between
+// the weighted shares, such as the high and low halves of a hi-lo pot, or a tournament's prizes.
+
+// A claim on the pot.
+type Share struct {
+	Account *Account
+
+	// Chips paid before the pot is divided.
+	Amount int
+
+	// The account's part of the pot left after fixed amounts, relative to the other weights.
+	// Example: 50, 30 and 20 pays 50%, 30% and 20%.  Zero for fixed amounts only.
+	Weight int
+}
+
+// How to divide the pot.
+type PayoutPlan struct {
+	Shares []Share
+
+	// Decides who receives the chips left over when weighted shares cannot be paid exactly.  The rule
+	// is given the weighted accounts, in plan order.  A nil rule uses plan order.  Use KeepOddChips to
+	// leave them in the pot.
+	OddChips OddChipRule
+}
+
+// Chips paid for a share.
+type Payment struct {
+	Account *Account
+
+	// The fixed amount, weighted share and any odd chip.
+	Amount int
+
+	// The odd chips included in Amount.
+	OddChips int
+}
+
+// Exactly what a plan paid.
+type PayoutReport struct {
+	// One payment per share, in plan order.
+	Payments []Payment
+
+	// Chips left in the pot.
+	Remaining int
+}
+
+// Leaves odd chips in the pot, to be won in later hands.
+func KeepOddChips(winners []*Account) []*Account {
+	return nil
+}
+
+// Pays the pot according to a plan.
+//
+// Weighted shares are rounded down, and the odd chips are handed out by the plan's rule, one chip
+// each.  Contributions are cleared, as with Payout.
+//
+// Returns ErrNoRecipients when the plan has no shares or a share has no account, ErrInvalidAmount
+// when a share is negative or empty, ErrAccountClosed when an account is closed, and ErrPotTooSmall
+// when the fixed amounts exceed the pot.  The plan is checked before any money moves.
+func (h *House) PayoutWith(plan PayoutPlan) (PayoutReport, error) {
+	return h.payoutWith(Reference{}, plan)
+}
+
+// Pays the pot according to a plan, tagging the ledger entries with a reference.
+func (h *House) payoutWith(ref Reference, plan PayoutPlan) (PayoutReport, error) {
+	if len(plan.Shares) == 0 {
+		return PayoutReport{}, ErrNoRecipients
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var fixed, weights int
+	var weighted []*Account
+	for _, share := range plan.Shares {
+		switch {
+		case share.Account == nil:
+			return PayoutReport{}, ErrNoRecipients
+		case share.Amount < 0 || share.Weight < 0 || share.Amount+share.Weight == 0:
+			return PayoutReport{}, ErrInvalidAmount
+		case share.Account.Closed():
+			return PayoutReport{}, ErrAccountClosed
+		}
+
+		fixed += share.Amount
+		weights += share.Weight
+		if share.Weight > 0 {
+			weighted = append(weighted, share.Account)
+		}
+	}
+
+	if fixed > h.pot.Balance {
+		return PayoutReport{}, ErrPotTooSmall{Required: fixed, Available: h.pot.Balance}
+	}
+
+	divided := h.pot.Balance - fixed
+	odd := divided
+
+	report := PayoutReport{Payments: make([]Payment, len(plan.Shares))}
+	for i, share := range plan.Shares {
+		report.Payments[i] = Payment{Account: share.Account, Amount: share.Amount}
+		if share.Weight > 0 {
+			portion := divided * share.Weight / weights
+			report.Payments[i].Amount += portion
+			odd -= portion
+		}
+	}
+
+	if plan.OddChips != nil {
+		weighted = plan.OddChips(weighted)
+	}
+
+	for _, account := range weighted {
+		if odd == 0 {
+			break
+		}
+
+		// One chip per share.  An account with several shares may receive several chips.
+		for i, share := range plan.Shares {
+			if share.Account == account && share.Weight > 0 && report.Payments[i].OddChips == 0 {
+				report.Payments[i].Amount++
+				report.Payments[i].OddChips++
+				odd--
+				break
+			}
+		}
+	}
+
+	accounts := make([]*Account, len(report.Payments))
+	amounts := make([]int, len(report.Payments))
+	for i, payment := range report.Payments {
+		accounts[i] = payment.Account
+		amounts[i] = payment.Amount
+	}
+
+	if err := h.payAll(accounts, amounts, ref); err != nil {
+		return PayoutReport{}, err
+	}
+
+	h.resetContributions()
+	report.Remaining = h.pot.Balance
+
+	return report, nil
+}
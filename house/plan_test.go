@@ -0,0 +1,156 @@
+package house_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/David-Rushton/card-collection/house"
+)
+
+func Test_PayoutWith_DividesPotByPlan(t *testing.T) {
+	testCases := []struct {
+		name      string
+		pot       int
+		shares    func(a, b, c *house.Account) []house.Share
+		oddChips  func(a, b, c *house.Account) house.OddChipRule
+		expected  []int
+		remaining int
+	}{
+		{
+			name: "hi-lo halves",
+			pot:  101,
+			shares: func(a, b, c *house.Account) []house.Share {
+				return []house.Share{{Account: a, Weight: 1}, {Account: b, Weight: 1}}
+			},
+			expected: []int{51, 50},
+		},
+		{
+			name: "tournament prizes",
+			pot:  1001,
+			shares: func(a, b, c *house.Account) []house.Share {
+				return []house.Share{{Account: a, Weight: 50}, {Account: b, Weight: 30}, {Account: c, Weight: 20}}
+			},
+			expected: []int{501, 300, 200},
+		},
+		{
+			name: "blackjack 3:2, then the rest to the dealer",
+			pot:  100,
+			shares: func(a, b, c *house.Account) []house.Share {
+				return []house.Share{{Account: a, Amount: 25}, {Account: c, Weight: 1}}
+			},
+			expected: []int{25, 75},
+		},
+		{
+			name: "fixed amounts only",
+			pot:  100,
+			shares: func(a, b, c *house.Account) []house.Share {
+				return []house.Share{{Account: a, Amount: 30}, {Account: b, Amount: 20}}
+			},
+			expected:  []int{30, 20},
+			remaining: 50,
+		},
+		{
+			name: "odd chips by seat",
+			pot:  5,
+			shares: func(a, b, c *house.Account) []house.Share {
+				return []house.Share{{Account: a, Weight: 1}, {Account: b, Weight: 1}, {Account: c, Weight: 1}}
+			},
+			oddChips: func(a, b, c *house.Account) house.OddChipRule {
+				return house.SeatOrder(c, a, b)
+			},
+			expected: []int{2, 1, 2},
+		},
+		{
+			name: "odd chips kept",
+			pot:  5,
+			shares: func(a, b, c *house.Account) []house.Share {
+				return []house.Share{{Account: a, Weight: 1}, {Account: b, Weight: 1}}
+			},
+			oddChips: func(a, b, c *house.Account) house.OddChipRule {
+				return house.KeepOddChips
+			},
+			expected:  []int{2, 2},
+			remaining: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			bank := house.New()
+			a, b, c := bank.NewAccount("a", 0), bank.NewAccount("b", 0), bank.NewAccount("c", 0)
+			bank.Bet(bank.NewAccount("dealer", testCase.pot), testCase.pot)
+
+			plan := house.PayoutPlan{Shares: testCase.shares(a, b, c)}
+			if testCase.oddChips != nil {
+				plan.OddChips = testCase.oddChips(a, b, c)
+			}
+
+			report, err := bank.PayoutWith(plan)
+			if err != nil {
+				t.Fatalf("❌ Unexpected error.  Expected: Nil.  Actual: %v.", err)
+			}
+
+			var actual []int
+			for i, payment := range report.Payments {
+				actual = append(actual, payment.Amount)
+				if payment.Account.Balance != payment.Amount || payment.Account != plan.Shares[i].Account {
+					t.Errorf("❌ Payment does not match the account.  Payment: %+v.", payment)
+				}
+			}
+
+			if !slices.Equal(actual, testCase.expected) || report.Remaining != testCase.remaining || bank.PotBalance() != testCase.remaining {
+				t.Errorf("❌ Unexpected payout.  Expected: %v, leaving %v.  Actual: %v, leaving %v.", testCase.expected, testCase.remaining, actual, report.Remaining)
+			}
+		})
+	}
+}
+
+func Test_PayoutWith_ReportsOddChips(t *testing.T) {
+	bank := house.New()
+	alice, bob, _ := newAccounts(10, 10)
+	bank.Bet(alice, 3)
+	bank.Bet(bob, 2)
+
+	report, _ := bank.PayoutWith(house.PayoutPlan{
+		Shares:   []house.Share{{Account: alice, Weight: 1}, {Account: bob, Weight: 1}},
+		OddChips: house.SeatOrder(bob, alice),
+	})
+
+	expected := []house.Payment{{Account: alice, Amount: 2}, {Account: bob, Amount: 3, OddChips: 1}}
+	if !slices.Equal(report.Payments, expected) {
+		t.Errorf("❌ Unexpected payments.  Expected: %+v.  Actual: %+v.", expected, report.Payments)
+	}
+}
+
+func Test_PayoutWith_ReturnsError_WhenPlanInvalid(t *testing.T) {
+	bank := house.New()
+	alice := bank.NewAccount("alice", 100)
+	closed := bank.NewAccount("closed", 0)
+	bank.CloseAccount(closed)
+	bank.Bet(alice, 50)
+
+	testCases := []struct {
+		shares   []house.Share
+		expected error
+	}{
+		{nil, house.ErrNoRecipients},
+		{[]house.Share{{Account: alice, Weight: 1}, {Weight: 1}}, house.ErrNoRecipients},
+		{[]house.Share{{Account: alice}}, house.ErrInvalidAmount},
+		{[]house.Share{{Account: alice, Weight: -1}}, house.ErrInvalidAmount},
+		{[]house.Share{{Account: alice, Amount: -10, Weight: 1}}, house.ErrInvalidAmount},
+		{[]house.Share{{Account: alice, Weight: 1}, {Account: closed, Weight: 1}}, house.ErrAccountClosed},
+		{[]house.Share{{Account: alice, Amount: 51}}, house.ErrPotTooSmall{Required: 51, Available: 50}},
+	}
+
+	for _, testCase := range testCases {
+		_, err := bank.PayoutWith(house.PayoutPlan{Shares: testCase.shares})
+		if !errors.Is(err, testCase.expected) {
+			t.Errorf("❌ Unexpected error for %+v.  Expected: %v.  Actual: %v.", testCase.shares, testCase.expected, err)
+		}
+	}
+
+	if alice.Balance != 50 || bank.PotBalance() != 50 {
+		t.Errorf("❌ Expected nothing to move.  Actual: %v, with %v in the pot.", alice.Balance, bank.PotBalance())
+	}
+}
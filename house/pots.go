@@ -0,0 +1,222 @@
+package house
+
+import (
+	"slices"
+)
+
+// Side pots.
+//
+// Every bet is recorded against the account that made it.  When a player is all in for less than
+// the others, the pot is divided into a main pot and side pots.  Each pot can only be won by the
+// players who paid into it.
+
+// A share of the chips bet, and the accounts that may win it.
+type Pot struct {
+	Amount int
+
+	// The accounts that paid into the pot and have not forfeited or closed, in the order they first
+	// bet.
+	Eligible []*Account
+}
+
+// Chips paid to an account from a pot.
+type Award struct {
+	Account *Account
+	Amount  int
+
+	// The pot the chips came from.  0 is the main pot, and 1 onwards are side pots.
+	Pot int
+}
+
+// Decides who receives the chips left over when a pot cannot be split evenly.
+// Returns the winners in the order they receive odd chips, one chip each.
+type OddChipRule func(winners []*Account) []*Account
+
+// Gives odd chips to the winners in seat order.
+// List the seats starting with the first seat left of the button.
+func SeatOrder(seats ...*Account) OddChipRule {
+	return func(winners []*Account) []*Account {
+		result := slices.Clone(winners)
+		slices.SortStableFunc(result, func(a, b *Account) int {
+			return slices.Index(seats, a) - slices.Index(seats, b)
+		})
+
+		return result
+	}
+}
+
+// Returns the chips an account has bet, since the last payout.
+func (h *House) Contribution(account *Account) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.contributions[account]
+}
+
+// Gives up the account's claim on the pot, such as when a player folds.  Closed accounts also lose
+// their claim.
+// Chips already bet remain in the pot, for the other players to win.
+func (h *House) Forfeit(account *Account) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.forfeited[account] = true
+}
+
+// Divides the chips bet into a main pot, followed by any side pots.
+//
+// A new pot starts at each amount a player still in the hand has bet in total.  Chips left in the
+// pot by an earlier payout are added to the main pot.
+func (h *House) Pots() []Pot {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.pots()
+}
+
+// Divides the chips bet into pots.  Callers must hold the house's lock.
+func (h *House) pots() []Pot {
+	var levels []int
+	for _, account := range h.contributors {
+		if h.eligible(account) {
+			levels = append(levels, h.contributions[account])
+		}
+	}
+	slices.Sort(levels)
+	levels = slices.Compact(levels)
+
+	var result []Pot
+	previous := 0
+	for _, level := range levels {
+		layer := Pot{}
+		for _, account := range h.contributors {
+			contribution := h.contributions[account]
+			layer.Amount += min(contribution, level) - min(contribution, previous)
+
+			if contribution >= level && h.eligible(account) {
+				layer.Eligible = append(layer.Eligible, account)
+			}
+		}
+
+		result = append(result, layer)
+		previous = level
+	}
+
+	// Chips that do not belong to a pot.  Forfeited bets larger than any live bet go to the last
+	// pot.  Chips carried over from an earlier payout go to the main pot.
+	if len(result) > 0 {
+		counted := 0
+		for _, account := range h.contributors {
+			counted += min(h.contributions[account], previous)
+		}
+
+		var forfeitedExcess int
+		for _, account := range h.contributors {
+			forfeitedExcess += max(h.contributions[account]-previous, 0)
+		}
+
+		result[len(result)-1].Amount += forfeitedExcess
+		result[0].Amount += h.pot.Balance - counted - forfeitedExcess
+	}
+
+	return result
+}
+
+// Pays each pot to the best eligible accounts.
+//
+// ranking lists the accounts from best hand to worst.  Accounts that tie share a group.  Each pot
+// is won by the best group containing an eligible account, and split between the eligible accounts
+// in that group.  Odd chips are handed out by oddChips.  A nil rule uses the order of the group.
+// The rule is called while the house is locked, so must not use the house.
+//
+// A pot without a ranked, eligible account stays in the house.  Contributions are cleared once
+// paid.
+//
+// Returns ErrAccountClosed when a winner's account is closed while being paid.  Every award is
+// checked before any money moves, so nothing is paid when an error is returned.
+func (h *House) PayoutPots(ranking [][]*Account, oddChips OddChipRule) ([]Award, error) {
+	return h.payoutPots(Reference{}, ranking, oddChips)
+}
+
+// Pays each pot, tagging the ledger entries with a reference.
+func (h *House) payoutPots(ref Reference, ranking [][]*Account, oddChips OddChipRule) ([]Award, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var awards []Award
+	for i, p := range h.pots() {
+		winners := potWinners(p, ranking)
+		if len(winners) == 0 {
+			continue
+		}
+
+		if oddChips != nil {
+			winners = oddChips(winners)
+		}
+
+		share := p.Amount / len(winners)
+		odd := p.Amount % len(winners)
+		for j, winner := range winners {
+			amount := share
+			if j < odd {
+				amount++
+			}
+
+			awards = append(awards, Award{Account: winner, Amount: amount, Pot: i})
+		}
+	}
+
+	accounts := make([]*Account, len(awards))
+	amounts := make([]int, len(awards))
+	for i, award := range awards {
+		accounts[i] = award.Account
+		amounts[i] = award.Amount
+	}
+
+	if err := h.payAll(accounts, amounts, ref); err != nil {
+		return nil, err
+	}
+
+	h.resetContributions()
+
+	return awards, nil
+}
+
+// Returns the eligible accounts in the best ranked group that contains any.
+func potWinners(p Pot, ranking [][]*Account) []*Account {
+	for _, group := range ranking {
+		var winners []*Account
+		for _, account := range group {
+			if slices.Contains(p.Eligible, account) {
+				winners = append(winners, account)
+			}
+		}
+
+		if len(winners) > 0 {
+			return winners
+		}
+	}
+
+	return nil
+}
+
+// True when the account may still win the pot.
+// Callers must hold the house's lock.
+func (h *House) eligible(account *Account) bool {
+	return !h.forfeited[account] && !account.Closed()
+}
+
+// Records a bet against an account.
+func (h *House) contribute(account *Account, amount int) {
+	if _, ok := h.contributions[account]; !ok {
+		h.contributors = append(h.contributors, account)
+	}
+
+	h.contributions[account] += amount
+}
+
+func (h *House) resetContributions() {
+	h.contributions = map[*Account]int{}
+	h.contributors = nil
+	h.forfeited = map[*Account]bool{}
+}
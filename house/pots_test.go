@@ -0,0 +1,181 @@
+package house_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/David-Rushton/card-collection/house"
+)
+
+func Test_Pots_BuildsSidePots_WhenPlayerAllIn(t *testing.T) {
+	bank := house.New()
+
+	short, bob, cara := newAccounts(50, 1000, 1000)
+	bank.Bet(short, 50)
+	bank.Bet(bob, 100)
+	bank.Bet(cara, 100)
+
+	actual := bank.Pots()
+
+	if len(actual) != 2 {
+		t.Fatalf("❌ Expected a main pot and a side pot.  Actual: %v.", actual)
+	}
+
+	assertPot(t, actual[0], 150, short, bob, cara)
+	assertPot(t, actual[1], 100, bob, cara)
+}
+
+func Test_Pots_ExcludesForfeitedAccounts(t *testing.T) {
+	bank := house.New()
+
+	short, bob, cara := newAccounts(50, 1000, 1000)
+	bank.Bet(short, 50)
+	bank.Bet(bob, 100)
+	bank.Bet(cara, 80)
+	bank.Forfeit(cara)
+
+	actual := bank.Pots()
+
+	// Cara's chips stay in the pots, but she cannot win them.
+	if len(actual) != 2 {
+		t.Fatalf("❌ Expected a main pot and a side pot.  Actual: %v.", actual)
+	}
+
+	assertPot(t, actual[0], 150, short, bob)
+	assertPot(t, actual[1], 80, bob)
+}
+
+func Test_Pots_ExcludesClosedAccounts(t *testing.T) {
+	bank := house.New()
+
+	alice, bob, _ := newAccounts(100, 100)
+	bank.Bet(alice, 50)
+	bank.Bet(bob, 50)
+	bank.CloseAccount(alice)
+
+	awards, err := bank.PayoutPots([][]*house.Account{{alice}, {bob}}, nil)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	expected := []house.Award{{Account: bob, Amount: 100, Pot: 0}}
+	if !slices.Equal(awards, expected) {
+		t.Errorf("❌ Unexpected awards.  Expected: %v.  Actual: %v.", expected, awards)
+	}
+}
+
+func Test_PayoutPots_AwardsEachPotToBestEligibleHand(t *testing.T) {
+	bank := house.New()
+
+	short, bob, cara := newAccounts(50, 1000, 1000)
+	bank.Bet(short, 50)
+	bank.Bet(bob, 100)
+	bank.Bet(cara, 100)
+
+	// The short stack has the best hand, but can only win the main pot.  Bob and cara tie for the
+	// side pot.
+	awards, err := bank.PayoutPots([][]*house.Account{{short}, {bob, cara}}, nil)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	expected := []house.Award{
+		{Account: short, Amount: 150, Pot: 0},
+		{Account: bob, Amount: 50, Pot: 1},
+		{Account: cara, Amount: 50, Pot: 1},
+	}
+
+	if !slices.Equal(awards, expected) {
+		t.Errorf("❌ Unexpected awards.  Expected: %v.  Actual: %v.", expected, awards)
+	}
+
+	if short.Balance != 150 || bob.Balance != 950 || cara.Balance != 950 || bank.PotBalance() != 0 {
+		t.Errorf("❌ Unexpected balances: %v, %v, %v with %v in the pot.", short.Balance, bob.Balance, cara.Balance, bank.PotBalance())
+	}
+
+	if bank.Contribution(bob) != 0 || len(bank.Pots()) != 0 {
+		t.Errorf("❌ Expected contributions to be cleared after the payout.")
+	}
+}
+
+func Test_PayoutPots_GivesOddChipsBySeat(t *testing.T) {
+	bank := house.New()
+
+	alice, bob, cara := newAccounts(10, 10, 10)
+	bank.Bet(alice, 1)
+	bank.Bet(bob, 1)
+	bank.Bet(cara, 1)
+
+	// Cara is first left of the button, so takes the odd chip.
+	bank.PayoutPots([][]*house.Account{{bob, cara}}, house.SeatOrder(cara, alice, bob))
+
+	if bob.Balance != 10 || cara.Balance != 11 || bank.PotBalance() != 0 {
+		t.Errorf("❌ Unexpected balances.  Bob: %v.  Cara: %v.  Pot: %v.", bob.Balance, cara.Balance, bank.PotBalance())
+	}
+}
+
+func Test_PayoutPots_PaysNothing_WhenWinnerClosedDuringPayout(t *testing.T) {
+	bank := house.New()
+
+	alice, bob, _ := newAccounts(100, 100)
+	bank.Bet(alice, 50)
+	bank.Bet(bob, 50)
+
+	// The odd chip rule runs while the pot is being paid.  Closing bob through another house stands
+	// in for a concurrent close.
+	closer := house.New()
+	closeBob := func(winners []*house.Account) []*house.Account {
+		closer.CloseAccount(bob)
+		return winners
+	}
+
+	awards, err := bank.PayoutPots([][]*house.Account{{alice, bob}}, closeBob)
+
+	if !errors.Is(err, house.ErrAccountClosed) || awards != nil {
+		t.Errorf("❌ Unexpected result.  Expected: ErrAccountClosed, without awards.  Actual: %v, %v.", err, awards)
+	}
+
+	if alice.Balance != 50 || bank.PotBalance() != 100 {
+		t.Errorf("❌ Expected nothing to be paid.  Actual: %v, with %v in the pot.", alice.Balance, bank.PotBalance())
+	}
+}
+
+func Test_PayoutPots_AddsCarriedChipsToMainPot(t *testing.T) {
+	bank := house.New()
+
+	alice, bob, _ := newAccounts(100, 100)
+
+	// Leaves one chip in the pot.
+	bank.Bet(alice, 1)
+	bank.Bet(bob, 2)
+	bank.Payout(alice, bob)
+
+	bank.Bet(alice, 10)
+	bank.Bet(bob, 10)
+	bank.PayoutPots([][]*house.Account{{alice}}, nil)
+
+	if alice.Balance != 111 || bank.PotBalance() != 0 {
+		t.Errorf("❌ Unexpected balance.  Expected: 111 with an empty pot.  Actual: %v with %v in the pot.", alice.Balance, bank.PotBalance())
+	}
+}
+
+func newAccounts(balances ...int) (*house.Account, *house.Account, *house.Account) {
+	accounts := make([]*house.Account, 3)
+	for i := range accounts {
+		accounts[i] = &house.Account{}
+		if i < len(balances) {
+			accounts[i].Balance = balances[i]
+		}
+	}
+
+	return accounts[0], accounts[1], accounts[2]
+}
+
+func assertPot(t *testing.T, actual house.Pot, amount int, eligible ...*house.Account) {
+	t.Helper()
+
+	if actual.Amount != amount || !slices.Equal(actual.Eligible, eligible) {
+		t.Errorf("❌ Unexpected pot.  Expected: %v for %v accounts.  Actual: %v for %v accounts.", amount, len(eligible), actual.Amount, len(actual.Eligible))
+	}
+}
@@ -0,0 +1,40 @@
+package house
+
+// Moves money for a single game.
+//
+// Every bet and payout made through a session is tagged with its reference in the ledger, so the
+// game's transactions can be found with EntriesForHand.  Sessions share their house's pot.
+type Session struct {
+	house     *House
+	reference Reference
+}
+
+// Returns a session that tags its transactions with a reference.
+func (h *House) Session(ref Reference) Session {
+	return Session{house: h, reference: ref}
+}
+
+// Returns the reference used to tag transactions.
+func (s Session) Reference() Reference {
+	return s.reference
+}
+
+// Moves money from an account into the pot.  See House.Bet.
+func (s Session) Bet(account *Account, amount int) error {
+	return s.house.bet(s.reference, account, amount)
+}
+
+// Shares the pot equally between all players.  See House.Payout.
+func (s Session) Payout(accounts ...*Account) error {
+	return s.house.payout(s.reference, accounts...)
+}
+
+// Pays each pot to the best eligible accounts.  See House.PayoutPots.
+func (s Session) PayoutPots(ranking [][]*Account, oddChips OddChipRule) ([]Award, error) {
+	return s.house.payoutPots(s.reference, ranking, oddChips)
+}
+
+// Pays the pot according to a plan.  See House.PayoutWith.
+func (s Session) PayoutWith(plan PayoutPlan) (PayoutReport, error) {
+	return s.house.payoutWith(s.reference, plan)
+}
@@ -0,0 +1,70 @@
+package poker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/David-Rushton/card-collection/deck"
+)
+
+// Returns the hand name.  Example: Full House.
+func (n HandName) String() string {
+	if name, ok := handNames[n]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("HandName(%d)", int(n))
+}
+
+// Returns a precise description of the hand, in English.
+// Examples: "Full House, Kings full of Sevens" or "Two Pairs, Aces and Fours with a Queen kicker".
+func (p PokerHand) Describe() string {
+	return p.DescribeWith(deck.Words{})
+}
+
+// Returns a precise description of the hand, using words to name ranks and suits.
+// Hands without five cards are described by name only.
+func (p PokerHand) DescribeWith(words deck.Words) string {
+	name := p.Name.String()
+	if len(p.Hand) != 5 {
+		return name
+	}
+
+	rank := func(i int) string { return words.RankName(p.Hand[i].Rank) }
+	plural := func(i int) string { return words.PluralName(p.Hand[i].Rank) }
+	kicker := func(i int) string { return fmt.Sprintf("with %v %v kicker", article(rank(i)), rank(i)) }
+
+	// Straights and flushes are held lowest card first.
+	high := words.RankName(p.Hand[4].Rank)
+	suit := words.SuitName(p.Hand[4].Suit)
+
+	switch p.Name {
+	case HighCard:
+		return fmt.Sprintf("%v, %v high", name, rank(0))
+	case Pair:
+		return fmt.Sprintf("%v, %v %v", name, plural(0), kicker(2))
+	case TwoPairs:
+		return fmt.Sprintf("%v, %v and %v %v", name, plural(0), plural(2), kicker(4))
+	case ThreeOfAKind:
+		return fmt.Sprintf("%v, %v %v", name, plural(0), kicker(3))
+	case Straight:
+		return fmt.Sprintf("%v, %v high", name, high)
+	case Flush, StraightFlush:
+		return fmt.Sprintf("%v, %v high in %v", name, high, suit)
+	case FullHouse:
+		return fmt.Sprintf("%v, %v full of %v", name, plural(0), plural(3))
+	case FourOfAKind:
+		return fmt.Sprintf("%v, %v %v", name, plural(0), kicker(4))
+	default:
+		return name
+	}
+}
+
+// Returns the English article for a word.
+func article(word string) string {
+	if word != "" && strings.ContainsRune("AEIOU", rune(word[0])) {
+		return "an"
+	}
+
+	return "a"
+}
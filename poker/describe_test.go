@@ -0,0 +1,64 @@
+package poker_test
+
+import (
+	"testing"
+
+	"github.com/David-Rushton/card-collection/deck"
+	"github.com/David-Rushton/card-collection/poker"
+)
+
+func Test_HandName_String(t *testing.T) {
+	testCases := []struct {
+		name     poker.HandName
+		expected string
+	}{
+		{poker.HighCard, "High Card"},
+		{poker.TwoPairs, "Two Pairs"},
+		{poker.RoyalFlush, "Royal Flush"},
+		{poker.HandName(42), "HandName(42)"},
+	}
+
+	for _, testCase := range testCases {
+		if actual := testCase.name.String(); actual != testCase.expected {
+			t.Errorf("❌ Unexpected name.  Expected: %v.  Actual: %v.", testCase.expected, actual)
+		}
+	}
+}
+
+func Test_PokerHand_Describe(t *testing.T) {
+	testCases := []struct {
+		hand     string
+		expected string
+	}{
+		{"Ah Jd 9c 6s 2h", "High Card, Ace high"},
+		{"Kh Kd Ac 6s 2h", "Pair, Kings with an Ace kicker"},
+		{"6h 6d Qc 9s 2h", "Pair, Sixes with a Queen kicker"},
+		{"Ah Ad 4c 4s Qh", "Two Pairs, Aces and Fours with a Queen kicker"},
+		{"7h 7d 7c 8s 2h", "Three of a Kind, Sevens with an Eight kicker"},
+		{"5h 6d 7c 8s 9h", "Straight, Nine high"},
+		{"Ah 2d 3c 4s 5h", "Straight, Five high"},
+		{"2h 6h 9h Jh Kh", "Flush, King high in Hearts"},
+		{"Kh Kd Kc 7s 7h", "Full House, Kings full of Sevens"},
+		{"9h 9d 9c 9s Jh", "Four of a Kind, Nines with a Jack kicker"},
+		{"5s 6s 7s 8s 9s", "Straight Flush, Nine high in Spades"},
+		{"Th Jh Qh Kh Ah", "Royal Flush"},
+	}
+
+	for _, testCase := range testCases {
+		if actual := poker.BestHand(parseHand(testCase.hand)).Describe(); actual != testCase.expected {
+			t.Errorf("❌ Unexpected description for %v.  Expected: %v.  Actual: %v.", testCase.hand, testCase.expected, actual)
+		}
+	}
+}
+
+func Test_PokerHand_DescribeWith_UsesWords(t *testing.T) {
+	spanish := deck.Words{
+		Rank:   func(r deck.Rank) string { return map[deck.Rank]string{deck.King: "Rey", deck.Seven: "Siete"}[r] },
+		Plural: func(r deck.Rank) string { return map[deck.Rank]string{deck.King: "Reyes", deck.Seven: "Sietes"}[r] },
+	}
+
+	actual := poker.BestHand(parseHand("Kh Kd Kc 7s 7h")).DescribeWith(spanish)
+	if expected := "Full House, Reyes full of Sietes"; actual != expected {
+		t.Errorf("❌ Unexpected description.  Expected: %v.  Actual: %v.", expected, actual)
+	}
+}
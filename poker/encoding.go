@@ -0,0 +1,134 @@
+package poker
+
+import (
+	"encoding/json"
+	"slices"
+	"strconv"
+
+	"github.com/David-Rushton/card-collection/deck"
+)
+
+// Poker hands are encoded by their cards.  The name and score are derived from the cards when
+// decoding, so a decoded hand can always be trusted.
+
+var (
+	handNames = map[HandName]string{
+		HighCard:      "High Card",
+		Pair:          "Pair",
+		TwoPairs:      "Two Pairs",
+		ThreeOfAKind:  "Three of a Kind",
+		Straight:      "Straight",
+		Flush:         "Flush",
+		FullHouse:     "Full House",
+		FourOfAKind:   "Four of a Kind",
+		StraightFlush: "Straight Flush",
+		RoyalFlush:    "Royal Flush",
+	}
+)
+
+// Implements encoding.TextMarshaler.
+func (n HandName) MarshalText() ([]byte, error) {
+	name, ok := handNames[n]
+	if !ok {
+		return nil, ErrUnknownHandName{Name: strconv.Itoa(int(n))}
+	}
+
+	return []byte(name), nil
+}
+
+// Implements encoding.TextUnmarshaler.
+func (n *HandName) UnmarshalText(text []byte) error {
+	for k, v := range handNames {
+		if v == string(text) {
+			*n = k
+			return nil
+		}
+	}
+
+	return ErrUnknownHandName{Name: string(text)}
+}
+
+// Implements encoding.TextMarshaler.
+// Returns the short codes of the five cards.  Example: "Ah Kh 9h 5h 2h".
+func (p PokerHand) MarshalText() ([]byte, error) {
+	return p.Hand.MarshalText()
+}
+
+// Implements encoding.TextUnmarshaler.
+func (p *PokerHand) UnmarshalText(text []byte) error {
+	var hand deck.Hand
+	if err := hand.UnmarshalText(text); err != nil {
+		return err
+	}
+
+	return p.evaluate(hand)
+}
+
+type pokerHandJSON struct {
+	Score int64     `json:"score"`
+	Name  HandName  `json:"name"`
+	Hand  deck.Hand `json:"hand"`
+}
+
+// Implements json.Marshaler.
+// Example: {"score":...,"name":"Flush","hand":["Ah","Kh","9h","5h","2h"]}.
+func (p PokerHand) MarshalJSON() ([]byte, error) {
+	return json.Marshal(pokerHandJSON(p))
+}
+
+// Implements json.Unmarshaler.
+// The score is recalculated from the cards.  Returns ErrHandMismatch if the name disagrees.
+func (p *PokerHand) UnmarshalJSON(data []byte) error {
+	var decoded pokerHandJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	var result PokerHand
+	if err := result.evaluate(decoded.Hand); err != nil {
+		return err
+	}
+
+	if result.Name != decoded.Name {
+		return ErrHandMismatch
+	}
+
+	*p = result
+
+	return nil
+}
+
+// Implements encoding.BinaryMarshaler.
+// Returns one byte per card.
+func (p PokerHand) MarshalBinary() ([]byte, error) {
+	return p.Hand.MarshalBinary()
+}
+
+// Implements encoding.BinaryUnmarshaler.
+func (p *PokerHand) UnmarshalBinary(data []byte) error {
+	var hand deck.Hand
+	if err := hand.UnmarshalBinary(data); err != nil {
+		return err
+	}
+
+	return p.evaluate(hand)
+}
+
+// Replaces p with the best hand made from exactly five distinct cards.
+func (p *PokerHand) evaluate(hand deck.Hand) error {
+	if len(hand) != 5 {
+		return ErrInvalidCardCount{Min: 5, Max: 5, Actual: len(hand)}
+	}
+
+	if slices.ContainsFunc(hand, deck.Card.IsJoker) {
+		return ErrJokersNotSupported
+	}
+
+	if deck.NewCardSet(hand...).Count() != len(hand) {
+		return deck.ErrDuplicateCard{Card: firstDuplicate(hand)}
+	}
+
+	*p = BestHand(hand)
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package poker_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/David-Rushton/card-collection/poker"
+)
+
+func Test_PokerHand_JSON_RoundTrips(t *testing.T) {
+	hand := poker.BestHand(parseHand("2h 9h Kh 5h Ah"))
+
+	data, err := json.Marshal(hand)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	var actual poker.PokerHand
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if actual.Name != poker.Flush || actual.Score != hand.Score || !slices.Equal(actual.Hand, hand.Hand) {
+		t.Errorf("❌ Round trip failed.  Expected: %v.  Actual: %v.", hand, actual)
+	}
+}
+
+func Test_PokerHand_UnmarshalJSON_ReturnsError_WhenInvalid(t *testing.T) {
+	testCases := []string{
+		`{"name":"Royal Flush","hand":["2h","9h","Kh","5h","Ah"]}`,
+		`{"name":"Flush","hand":["2h","9h","Kh","5h"]}`,
+		`{"name":"Flush","hand":["2h","9h","Kh","5h","5h"]}`,
+		`{"name":"Best Hand Ever","hand":["2h","9h","Kh","5h","Ah"]}`,
+	}
+
+	for _, testCase := range testCases {
+		var hand poker.PokerHand
+		if err := json.Unmarshal([]byte(testCase), &hand); err == nil {
+			t.Errorf("❌ Missing error for %v.", testCase)
+		}
+	}
+}
+
+func Test_PokerHand_Text_RoundTrips(t *testing.T) {
+	hand := poker.BestHand(parseHand("Qc Qs Qh Th 9h"))
+
+	text, err := hand.MarshalText()
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	var actual poker.PokerHand
+	if err := actual.UnmarshalText(text); err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if actual.Name != poker.ThreeOfAKind || actual.Score != hand.Score {
+		t.Errorf("❌ Round trip failed.  Expected: %v.  Actual: %v.", hand, actual)
+	}
+}
+
+func Test_PokerHand_UnmarshalText_ReturnsErrJokersNotSupported(t *testing.T) {
+	var hand poker.PokerHand
+	if err := hand.UnmarshalText([]byte("RJ Ah Kh Qh Jh")); err != poker.ErrJokersNotSupported {
+		t.Errorf("❌ Unexpected error.  Expected: ErrJokersNotSupported.  Actual: %v.", err)
+	}
+}
+
+func Test_PokerHand_Binary_RoundTrips(t *testing.T) {
+	hand := poker.BestHand(parseHand("Td Jd Qd Kd Ad"))
+
+	data, err := hand.MarshalBinary()
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if len(data) != 5 {
+		t.Errorf("❌ Unexpected length.  Expected: 5.  Actual: %v.", len(data))
+	}
+
+	var actual poker.PokerHand
+	if err := actual.UnmarshalBinary(data); err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if actual.Name != poker.RoyalFlush || actual.Score != hand.Score {
+		t.Errorf("❌ Round trip failed.  Expected: %v.  Actual: %v.", hand, actual)
+	}
+}
+
+func Test_HandName_MarshalText_ReturnsName(t *testing.T) {
+	actual, err := poker.FullHouse.MarshalText()
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if string(actual) != "Full House" {
+		t.Errorf("❌ Unexpected name.  Expected: Full House.  Actual: %s.", actual)
+	}
+
+	if _, err := poker.HandName(99).MarshalText(); err == nil {
+		t.Errorf("❌ Missing error for unknown hand name.")
+	}
+}
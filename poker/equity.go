@@ -0,0 +1,560 @@
+package poker
+
+import (
+	"context"
+	"math/rand/v2"
+	"runtime"
+	"sort"
+	"sync"
+
+	"github.com/David-Rushton/card-collection/deck"
+)
+
+const (
+	// Boards are enumerated when there are no more than this many to check.
+	// Otherwise boards are sampled at random.
+	DefaultMaxEnumerations = 2_000_000
+
+	// The number of random boards sampled, when enumeration is not feasible.
+	DefaultTrials = 200_000
+
+	// How often workers check for cancellation.
+	cancellationInterval = 1024
+
+	// How many times a sampler tries to deal ranges without two players sharing a card.
+	maxDealAttempts = 1000
+)
+
+// Describes a Texas hold'em equity calculation.
+type EquityRequest struct {
+	// Hole cards for each player.  Between 2 and 10 players, each with 2 cards.
+	Hands []deck.Hand
+
+	// Weighted hole cards for each player, as an alternative to Hands.  Each trial deals every
+	// player a holding, chosen in proportion to its weight.
+	// Holdings blocked by the board or dead cards are ignored.  Ranges are always sampled.
+	Ranges [][]Holding
+
+	// Community cards already dealt.  Up to 5 cards.
+	Board deck.Hand
+
+	// Cards known to be out of play, such as folded or burnt cards.
+	Dead deck.Hand
+
+	// Boards are enumerated when there are no more than this many to check.
+	// Zero uses DefaultMaxEnumerations.  Negative always samples.
+	MaxEnumerations int
+
+	// The number of random boards to sample, when not enumerating.
+	// Zero uses DefaultTrials.
+	Trials int
+
+	// Seeds the random sampler.
+	// Zero picks a random seed, which is recorded in the result.
+	Seed uint64
+
+	// The number of goroutines to use.
+	// Zero uses runtime.GOMAXPROCS.
+	Workers int
+}
+
+// Hole cards a player may hold, and how likely they are to hold them.
+type Holding struct {
+	// Exactly 2 cards.
+	Cards deck.CardSet
+
+	// Relative to the player's other holdings.  Holdings without a positive weight are ignored.
+	Weight float64
+}
+
+// A player's chances.
+// Win, Tie and Lose are fractions of the boards checked, and add up to 1.
+type Equity struct {
+	Win  float64
+	Tie  float64
+	Lose float64
+
+	// The player's expected share of the pot.
+	// A tie between two players counts as half a pot each.
+	Share float64
+}
+
+// The outcome of an equity calculation.
+type EquityResult struct {
+	// One per player, in the same order as the request.
+	Players []Equity
+
+	// The number of boards checked.
+	Trials int
+
+	// True when every possible board was checked.  False when boards were sampled.
+	Exhaustive bool
+
+	// The seed used to sample boards.
+	// Only meaningful when Exhaustive is false.  Use to reproduce a result.
+	Seed uint64
+}
+
+// Calculates each player's chance of winning, by completing the board.
+//
+// When the number of possible boards is small enough every board is checked.  Otherwise boards are
+// sampled at random.  Sampling is repeatable for the same seed and number of workers.
+//
+// When the request contains ranges, rather than hands, hole cards and boards are both sampled.
+// Returns ErrNoValidDeal if the ranges rarely, or never, allow each player distinct cards, and
+// ErrNotEnoughCards when too few unseen cards remain to complete the board.
+//
+// Work is spread across goroutines.  Returns ctx.Err() if the context is cancelled first.
+func CalculateEquity(ctx context.Context, request EquityRequest) (EquityResult, error) {
+	known, err := validateEquityRequest(request)
+	if err != nil {
+		return EquityResult{}, err
+	}
+
+	// The cards that may still appear on the board.
+	var unseen deck.Hand
+	for _, card := range deck.Standard.Cards() {
+		if !known.Contains(card) {
+			unseen = append(unseen, card)
+		}
+	}
+
+	job := equityJob{
+		players: len(request.Hands),
+		board:   deck.NewCardSet(request.Board...),
+		unseen:  unseen,
+		missing: 5 - len(request.Board),
+		workers: request.Workers,
+	}
+	if job.workers <= 0 {
+		job.workers = runtime.GOMAXPROCS(0)
+	}
+
+	if len(request.Ranges) > 0 {
+		job.players = len(request.Ranges)
+		job.ranges = make([]holeRange, len(request.Ranges))
+		for i, holdings := range request.Ranges {
+			if job.ranges[i], err = newHoleRange(i, holdings, known); err != nil {
+				return EquityResult{}, err
+			}
+		}
+	} else {
+		job.holes = make([]deck.CardSet, len(request.Hands))
+		for i, hand := range request.Hands {
+			job.holes[i] = deck.NewCardSet(hand...)
+		}
+	}
+
+	maxEnumerations := request.MaxEnumerations
+	if maxEnumerations == 0 {
+		maxEnumerations = DefaultMaxEnumerations
+	}
+
+	boards := combinations(len(unseen), job.missing)
+	if job.ranges == nil && boards <= maxEnumerations {
+		tally, err := job.enumerate(ctx)
+		if err != nil {
+			return EquityResult{}, err
+		}
+
+		return tally.result(true, 0), nil
+	}
+
+	trials := request.Trials
+	if trials <= 0 {
+		trials = DefaultTrials
+	}
+
+	seed := request.Seed
+	if seed == 0 {
+		seed = rand.Uint64()
+	}
+
+	tally, err := job.sample(ctx, trials, seed)
+	if err != nil {
+		return EquityResult{}, err
+	}
+
+	return tally.result(false, seed), nil
+}
+
+// Checks the request, and returns every card it contains.
+// Range holdings are checked later, as they may share cards.
+func validateEquityRequest(request EquityRequest) (deck.CardSet, error) {
+	if len(request.Hands) > 0 && len(request.Ranges) > 0 {
+		return 0, ErrHandsAndRanges
+	}
+
+	players := max(len(request.Hands), len(request.Ranges))
+	if players < 2 || players > 10 {
+		return 0, ErrInvalidPlayerCount{Min: 2, Max: 10, Actual: players}
+	}
+
+	for _, hand := range request.Hands {
+		if len(hand) != 2 {
+			return 0, ErrInvalidCardCount{Cards: "hole", Min: 2, Max: 2, Actual: len(hand)}
+		}
+	}
+
+	if len(request.Board) > 5 {
+		return 0, ErrInvalidCardCount{Cards: "board", Min: 0, Max: 5, Actual: len(request.Board)}
+	}
+
+	var known deck.CardSet
+	all := request.Board.Append(request.Dead, len(request.Dead))
+	for _, hand := range request.Hands {
+		all = all.Append(hand, len(hand))
+	}
+
+	for _, card := range all {
+		if card.IsJoker() {
+			return 0, ErrJokersNotSupported
+		}
+
+		if !card.IsValid() {
+			return 0, deck.ErrInvalidCard{Input: card.ShortString(), Reason: "Rank or suit out of range"}
+		}
+
+		if known.Contains(card) {
+			return 0, deck.ErrDuplicateCard{Card: card}
+		}
+
+		known.Add(card)
+	}
+
+	// Ranged players are dealt from the unseen cards, before the board.
+	available := deck.Standard.Size() - known.Count() - 2*len(request.Ranges)
+	if missing := 5 - len(request.Board); available < missing {
+		return 0, ErrNotEnoughCards{Required: missing, Available: available}
+	}
+
+	return known, nil
+}
+
+// Returns a player's holdings, without those blocked by known cards.
+// Returns ErrNoHoldings when none remain.
+func newHoleRange(player int, holdings []Holding, known deck.CardSet) (holeRange, error) {
+	var result holeRange
+	var total float64
+	for _, holding := range holdings {
+		if count := holding.Cards.Count(); count != 2 {
+			return holeRange{}, ErrInvalidCardCount{Cards: "hole", Min: 2, Max: 2, Actual: count}
+		}
+
+		if holding.Cards.Contains(deck.RedJoker) || holding.Cards.Contains(deck.BlackJoker) {
+			return holeRange{}, ErrJokersNotSupported
+		}
+
+		if holding.Weight <= 0 || holding.Cards.Intersect(known) != 0 {
+			continue
+		}
+
+		total += holding.Weight
+		result.cards = append(result.cards, holding.Cards)
+		result.cumulative = append(result.cumulative, total)
+	}
+
+	if len(result.cards) == 0 {
+		return holeRange{}, ErrNoHoldings{Player: player}
+	}
+
+	return result, nil
+}
+
+// A player's possible hole cards, ready for sampling.
+type holeRange struct {
+	cards []deck.CardSet
+
+	// The running total of the weights.  The last entry is the total weight.
+	cumulative []float64
+}
+
+// Picks a holding at random, in proportion to its weight.
+func (r holeRange) pick(rng *rand.Rand) deck.CardSet {
+	target := rng.Float64() * r.cumulative[len(r.cumulative)-1]
+	i := sort.SearchFloat64s(r.cumulative, target)
+
+	// Guards against rounding, when the target lands on the total.
+	return r.cards[min(i, len(r.cards)-1)]
+}
+
+// The cards needed to run a calculation.
+// Players either have fixed holes, or ranges that are sampled on each trial.
+type equityJob struct {
+	players int
+	holes   []deck.CardSet
+	ranges  []holeRange
+	board   deck.CardSet
+	unseen  deck.Hand
+	missing int
+	workers int
+}
+
+// Checks every possible board.
+// The first unseen card on each board is used to divide the work between workers.
+func (j equityJob) enumerate(ctx context.Context) (*equityTally, error) {
+	// A complete board only has one outcome.
+	if j.missing == 0 {
+		tally := newEquityTally(j.players)
+		tally.record(j.holes, j.board)
+		return tally, nil
+	}
+
+	firstCards := make(chan int)
+	go func() {
+		defer close(firstCards)
+		for i := 0; i <= len(j.unseen)-j.missing; i++ {
+			select {
+			case firstCards <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return j.run(ctx, func(_ int, tally *equityTally) error {
+		for first := range firstCards {
+			board := j.board
+			board.Add(j.unseen[first])
+
+			if err := j.enumerateFrom(ctx, tally, board, first+1, j.missing-1); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Adds each combination of the remaining unseen cards, starting from index, to the board.
+func (j equityJob) enumerateFrom(ctx context.Context, tally *equityTally, board deck.CardSet, index, missing int) error {
+	if missing == 0 {
+		if tally.trials%cancellationInterval == 0 && ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		tally.record(j.holes, board)
+		return nil
+	}
+
+	for i := index; i <= len(j.unseen)-missing; i++ {
+		next := board
+		next.Add(j.unseen[i])
+
+		if err := j.enumerateFrom(ctx, tally, next, i+1, missing-1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Checks randomly selected boards.  When players have ranges their hole cards are also selected.
+// Each worker has its own generator, derived from the seed.
+func (j equityJob) sample(ctx context.Context, trials int, seed uint64) (*equityTally, error) {
+	return j.run(ctx, func(worker int, tally *equityTally) error {
+		rng := rand.New(rand.NewPCG(seed, uint64(worker)))
+		unseen := append(deck.Hand{}, j.unseen...)
+
+		holes := j.holes
+		if j.ranges != nil {
+			holes = make([]deck.CardSet, j.players)
+		}
+
+		// Share the trials between workers.  The first workers take any remainder.
+		count := trials / j.workers
+		if worker < trials%j.workers {
+			count++
+		}
+
+		for trial := 0; trial < count; trial++ {
+			if trial%cancellationInterval == 0 && ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			var dealt deck.CardSet
+			if j.ranges != nil {
+				var err error
+				if dealt, err = j.deal(rng, holes); err != nil {
+					return err
+				}
+			}
+
+			// Partial Fisher-Yates shuffle.  Only the cards needed are moved.
+			// Cards dealt to players are passed over.
+			board := j.board
+			for i, drawn := 0, 0; drawn < j.missing; i++ {
+				swapAt := i + rng.IntN(len(unseen)-i)
+				unseen[i], unseen[swapAt] = unseen[swapAt], unseen[i]
+				if dealt.Contains(unseen[i]) {
+					continue
+				}
+
+				board.Add(unseen[i])
+				drawn++
+			}
+
+			tally.record(holes, board)
+		}
+
+		return nil
+	})
+}
+
+// Picks hole cards for each player from their range, and returns every card dealt.
+// Deals where players share a card are discarded as a whole, so no player's range is favoured.
+func (j equityJob) deal(rng *rand.Rand, holes []deck.CardSet) (deck.CardSet, error) {
+	for attempt := 0; attempt < maxDealAttempts; attempt++ {
+		var dealt deck.CardSet
+		valid := true
+		for i, r := range j.ranges {
+			holes[i] = r.pick(rng)
+			if dealt.Intersect(holes[i]) != 0 {
+				valid = false
+				break
+			}
+
+			dealt = dealt.Union(holes[i])
+		}
+
+		if valid {
+			return dealt, nil
+		}
+	}
+
+	return 0, ErrNoValidDeal
+}
+
+// Runs work on each worker, and combines their tallies.
+func (j equityJob) run(ctx context.Context, work func(worker int, tally *equityTally) error) (*equityTally, error) {
+	tallies := make([]*equityTally, j.workers)
+	errs := make([]error, j.workers)
+
+	var wg sync.WaitGroup
+	for worker := range tallies {
+		tallies[worker] = newEquityTally(j.players)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[worker] = work(worker, tallies[worker])
+		}()
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	result := newEquityTally(j.players)
+	for worker, tally := range tallies {
+		if errs[worker] != nil {
+			return nil, errs[worker]
+		}
+
+		result.add(tally)
+	}
+
+	return result, nil
+}
+
+// Counts outcomes, for each player.
+type equityTally struct {
+	trials int
+	wins   []int
+	ties   []int
+	shares []float64
+	scores []int64
+}
+
+func newEquityTally(players int) *equityTally {
+	return &equityTally{
+		wins:   make([]int, players),
+		ties:   make([]int, players),
+		shares: make([]float64, players),
+		scores: make([]int64, players),
+	}
+}
+
+// Scores each player's hand against a complete board, and records the outcome.
+func (t *equityTally) record(holes []deck.CardSet, board deck.CardSet) {
+	var best int64
+	winners := 0
+	for i, hole := range holes {
+		_, score, _ := FastScoreSet(hole.Union(board))
+		t.scores[i] = score
+
+		switch {
+		case score > best:
+			best = score
+			winners = 1
+		case score == best:
+			winners++
+		}
+	}
+
+	for i, score := range t.scores {
+		if score != best {
+			continue
+		}
+
+		if winners == 1 {
+			t.wins[i]++
+		} else {
+			t.ties[i]++
+		}
+
+		t.shares[i] += 1 / float64(winners)
+	}
+
+	t.trials++
+}
+
+// Adds another tally to this one.
+func (t *equityTally) add(other *equityTally) {
+	t.trials += other.trials
+	for i := range t.wins {
+		t.wins[i] += other.wins[i]
+		t.ties[i] += other.ties[i]
+		t.shares[i] += other.shares[i]
+	}
+}
+
+func (t *equityTally) result(exhaustive bool, seed uint64) EquityResult {
+	result := EquityResult{
+		Players:    make([]Equity, len(t.wins)),
+		Trials:     t.trials,
+		Exhaustive: exhaustive,
+		Seed:       seed,
+	}
+
+	if t.trials == 0 {
+		return result
+	}
+
+	trials := float64(t.trials)
+	for i := range result.Players {
+		result.Players[i] = Equity{
+			Win:   float64(t.wins[i]) / trials,
+			Tie:   float64(t.ties[i]) / trials,
+			Lose:  float64(t.trials-t.wins[i]-t.ties[i]) / trials,
+			Share: t.shares[i] / trials,
+		}
+	}
+
+	return result
+}
+
+// Returns n choose k.
+func combinations(n, k int) int {
+	if k < 0 || k > n {
+		return 0
+	}
+
+	result := 1
+	for i := 1; i <= k; i++ {
+		result = result * (n - k + i) / i
+	}
+
+	return result
+}
@@ -0,0 +1,200 @@
+package poker_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/David-Rushton/card-collection/deck"
+	"github.com/David-Rushton/card-collection/poker"
+)
+
+func Test_CalculateEquity_EnumeratesRiver(t *testing.T) {
+	// Eight outs for the open ended straight draw, from 44 unseen cards.
+	request := poker.EquityRequest{
+		Hands: []deck.Hand{parseHand("Ah Ad"), parseHand("8c 9c")},
+		Board: parseHand("6d 7s Kh 2c"),
+	}
+
+	actual, err := poker.CalculateEquity(context.Background(), request)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if !actual.Exhaustive || actual.Trials != 44 {
+		t.Errorf("❌ Expected all 44 rivers to be checked.  Actual: %v, exhaustive: %v.", actual.Trials, actual.Exhaustive)
+	}
+
+	assertEquity(t, actual.Players[1].Win, 8.0/44)
+	assertEquity(t, actual.Players[0].Win, 36.0/44)
+	assertEquity(t, actual.Players[0].Lose, 8.0/44)
+}
+
+func Test_CalculateEquity_SplitsTiedPots(t *testing.T) {
+	request := poker.EquityRequest{
+		Hands: []deck.Hand{parseHand("2c 3d"), parseHand("2d 3c")},
+		Board: parseHand("Ah Kd Qc Js Th"),
+	}
+
+	actual, err := poker.CalculateEquity(context.Background(), request)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	for _, player := range actual.Players {
+		assertEquity(t, player.Tie, 1)
+		assertEquity(t, player.Share, 0.5)
+	}
+}
+
+func Test_CalculateEquity_EnumeratesPreFlop(t *testing.T) {
+	// A well known match up.  Aces win about 82% of the time against kings.
+	request := poker.EquityRequest{
+		Hands: []deck.Hand{parseHand("Ah As"), parseHand("Kh Ks")},
+	}
+
+	actual, err := poker.CalculateEquity(context.Background(), request)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if !actual.Exhaustive || actual.Trials != 1_712_304 {
+		t.Errorf("❌ Expected all 1,712,304 boards to be checked.  Actual: %v.", actual.Trials)
+	}
+
+	if math.Abs(actual.Players[0].Share-0.82) > 0.01 {
+		t.Errorf("❌ Unexpected equity for aces.  Expected: about 0.82.  Actual: %v.", actual.Players[0].Share)
+	}
+}
+
+func Test_CalculateEquity_SamplesRepeatably(t *testing.T) {
+	request := poker.EquityRequest{
+		Hands:           []deck.Hand{parseHand("Ah As"), parseHand("Kh Ks"), parseHand("7c 8c")},
+		MaxEnumerations: -1,
+		Trials:          20_000,
+		Seed:            42,
+		Workers:         4,
+	}
+
+	first, err := poker.CalculateEquity(context.Background(), request)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	second, _ := poker.CalculateEquity(context.Background(), request)
+
+	if first.Exhaustive || first.Trials != 20_000 || first.Seed != 42 {
+		t.Errorf("❌ Unexpected sampling: %v trials, exhaustive: %v, seed: %v.", first.Trials, first.Exhaustive, first.Seed)
+	}
+
+	for i := range first.Players {
+		if first.Players[i] != second.Players[i] {
+			t.Errorf("❌ Same seed produced different results.  First: %v.  Second: %v.", first.Players[i], second.Players[i])
+		}
+	}
+
+	total := 0.0
+	for _, player := range first.Players {
+		total += player.Share
+	}
+	assertEquity(t, total, 1)
+}
+
+func Test_CalculateEquity_SamplesRanges(t *testing.T) {
+	// A range holding a single combo matches the hand.
+	aces := []poker.Holding{{Cards: deck.NewCardSet(parseHand("Ah As")...), Weight: 1}}
+	kings := []poker.Holding{
+		{Cards: deck.NewCardSet(parseHand("Kh Ks")...), Weight: 1},
+		{Cards: deck.NewCardSet(parseHand("Kd Kc")...), Weight: 0.5},
+		{Cards: deck.NewCardSet(parseHand("Ah Kc")...), Weight: 1},
+	}
+
+	request := poker.EquityRequest{
+		Ranges: [][]poker.Holding{aces, kings},
+		Board:  parseHand("Ac"),
+		Trials: 20_000,
+		Seed:   7,
+	}
+
+	actual, err := poker.CalculateEquity(context.Background(), request)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	// Ah Kc is blocked.  The remaining kings cannot beat a set of aces without a straight or flush.
+	if actual.Exhaustive || actual.Trials != 20_000 {
+		t.Errorf("❌ Expected ranges to be sampled.  Actual: %v trials, exhaustive: %v.", actual.Trials, actual.Exhaustive)
+	}
+
+	if actual.Players[0].Share < 0.9 {
+		t.Errorf("❌ Unexpected equity for aces.  Expected: over 0.9.  Actual: %v.", actual.Players[0].Share)
+	}
+}
+
+func Test_CalculateEquity_ReturnsError_WhenRangesInvalid(t *testing.T) {
+	aceKing := []poker.Holding{{Cards: deck.NewCardSet(parseHand("Ah Kh")...), Weight: 1}}
+	queens := []poker.Holding{{Cards: deck.NewCardSet(parseHand("Qh Qs")...), Weight: 1}}
+
+	testCases := []struct {
+		request  poker.EquityRequest
+		expected error
+	}{
+		{
+			request:  poker.EquityRequest{Hands: []deck.Hand{parseHand("Ah As")}, Ranges: [][]poker.Holding{queens}},
+			expected: poker.ErrHandsAndRanges,
+		},
+		{
+			request:  poker.EquityRequest{Ranges: [][]poker.Holding{aceKing, aceKing}},
+			expected: poker.ErrNoValidDeal,
+		},
+		{
+			request:  poker.EquityRequest{Ranges: [][]poker.Holding{queens, aceKing}, Dead: parseHand("Kh")},
+			expected: poker.ErrNoHoldings{Player: 1},
+		},
+	}
+
+	for _, testCase := range testCases {
+		if _, err := poker.CalculateEquity(context.Background(), testCase.request); !errors.Is(err, testCase.expected) {
+			t.Errorf("❌ Unexpected error.  Expected: %v.  Actual: %v.", testCase.expected, err)
+		}
+	}
+}
+
+func Test_CalculateEquity_ReturnsError_WhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	request := poker.EquityRequest{
+		Hands: []deck.Hand{parseHand("Ah As"), parseHand("Kh Ks")},
+	}
+
+	if _, err := poker.CalculateEquity(ctx, request); !errors.Is(err, context.Canceled) {
+		t.Errorf("❌ Unexpected error.  Expected: context.Canceled.  Actual: %v.", err)
+	}
+}
+
+func Test_CalculateEquity_ReturnsError_WhenRequestInvalid(t *testing.T) {
+	testCases := []poker.EquityRequest{
+		{Hands: []deck.Hand{parseHand("Ah As")}},
+		{Hands: []deck.Hand{parseHand("Ah As"), parseHand("Kh")}},
+		{Hands: []deck.Hand{parseHand("Ah As"), parseHand("Kh Ks")}, Board: parseHand("2c 3c 4c 5c 6c 7c")},
+		{Hands: []deck.Hand{parseHand("Ah As"), parseHand("Kh Ks")}, Dead: parseHand("Ah")},
+		// Clubs, diamonds, hearts and ace to five of spades are dead, leaving four cards for the board.
+		{Hands: []deck.Hand{parseHand("Ks Qs"), parseHand("Js Ts")}, Dead: deck.Standard.Cards()[:44], MaxEnumerations: -1},
+	}
+
+	for _, testCase := range testCases {
+		if _, err := poker.CalculateEquity(context.Background(), testCase); err == nil {
+			t.Errorf("❌ Missing error for %v.", testCase)
+		}
+	}
+}
+
+func assertEquity(t *testing.T, actual, expected float64) {
+	t.Helper()
+
+	if math.Abs(actual-expected) > 1e-9 {
+		t.Errorf("❌ Unexpected equity.  Expected: %v.  Actual: %v.", expected, actual)
+	}
+}
@@ -0,0 +1,100 @@
+package poker
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// Returned when a decoded poker hand does not match its cards.
+	ErrHandMismatch = errors.New("poker hand name does not match its cards")
+
+	// Returned when evaluating a hand that contains a joker.
+	ErrJokersNotSupported = errors.New("poker hands cannot contain jokers")
+
+	// Returned when a showdown has no players.
+	ErrNoPlayers = errors.New("showdown requires at least one player")
+
+	// Returned when an equity request contains both hands and ranges.
+	ErrHandsAndRanges = errors.New("equity request cannot contain both hands and ranges")
+
+	// Returned when players' ranges cannot be dealt without sharing cards.
+	ErrNoValidDeal = errors.New("ranges cannot be dealt without players sharing cards")
+)
+
+// Returned when an unrecognised hand name is decoded.
+type ErrUnknownHandName struct {
+	Name string
+}
+
+func (e ErrUnknownHandName) Error() string {
+	return fmt.Sprintf("Unknown hand name %q.", e.Name)
+}
+
+// Returned when a hand contains the wrong number of cards.
+type ErrInvalidCardCount struct {
+	// Which cards were counted.  Example: hole.  Blank when counting the whole hand.
+	Cards  string
+	Min    int
+	Max    int
+	Actual int
+}
+
+func (e ErrInvalidCardCount) Error() string {
+	expected := fmt.Sprintf("%d", e.Min)
+	if e.Max != e.Min {
+		expected = fmt.Sprintf("%d to %d", e.Min, e.Max)
+	}
+
+	cards := "cards"
+	if e.Cards != "" {
+		cards = e.Cards + " cards"
+	}
+
+	return fmt.Sprintf("Expected %v %v but found %d.", expected, cards, e.Actual)
+}
+
+// Returned when a player's cards cannot be evaluated at showdown.
+type ErrPlayerHand struct {
+	Player string
+	Err    error
+}
+
+func (e ErrPlayerHand) Error() string {
+	return fmt.Sprintf("Cannot evaluate %v's hand.  %v", e.Player, e.Err)
+}
+
+func (e ErrPlayerHand) Unwrap() error {
+	return e.Err
+}
+
+// Returned when a calculation has too few, or too many, players.
+type ErrInvalidPlayerCount struct {
+	Min    int
+	Max    int
+	Actual int
+}
+
+func (e ErrInvalidPlayerCount) Error() string {
+	return fmt.Sprintf("Expected %d to %d players but found %d.", e.Min, e.Max, e.Actual)
+}
+
+// Returned when every holding in a player's range is blocked by known cards.
+type ErrNoHoldings struct {
+	// Counted from zero, in request order.
+	Player int
+}
+
+func (e ErrNoHoldings) Error() string {
+	return fmt.Sprintf("Player %d has no holdings that can be dealt.", e.Player)
+}
+
+// Returned when too few unseen cards remain to complete the board.
+type ErrNotEnoughCards struct {
+	Required  int
+	Available int
+}
+
+func (e ErrNotEnoughCards) Error() string {
+	return fmt.Sprintf("Expected at least %d unseen cards but found %d.", e.Required, e.Available)
+}
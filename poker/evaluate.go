@@ -0,0 +1,58 @@
+package poker
+
+import "github.com/David-Rushton/card-collection/deck"
+
+// The best hand a player can make, from their hole cards and the board.
+type Evaluation struct {
+	PokerHand
+
+	// The hole cards used in the best hand.
+	HoleUsed deck.Hand
+
+	// The board cards used in the best hand.
+	BoardUsed deck.Hand
+
+	// The best qualifying low hand, for hi-lo variants.
+	// Nil when the variant is not hi-lo, or the player cannot make a low.
+	Low *LowHand
+}
+
+// Returns the best Texas hold'em hand that can be made from the board and hole cards.
+// See Variant.Evaluate.
+func Evaluate(board, hole deck.Hand) (Evaluation, error) {
+	return Holdem.Evaluate(board, hole)
+}
+
+// Returns the best hand that can be made from the board and hole cards.
+//
+// Returns ErrInvalidCardCount when the variant does not allow the number of hole or board cards,
+// deck.ErrDuplicateCard when a card appears more than once, and ErrJokersNotSupported when a
+// joker is found.
+func (v Variant) Evaluate(board, hole deck.Hand) (Evaluation, error) {
+	if err := v.validate(board, hole); err != nil {
+		return Evaluation{}, err
+	}
+
+	handName, hand := v.bestHand(board, hole)
+	result := Evaluation{
+		PokerHand: PokerHand{v.scoreHand(handName, hand), handName, hand},
+	}
+
+	// Split the hand into the cards that came from the player, and those from the board.
+	holeCards := deck.NewCardSet(hole...)
+	for _, card := range hand {
+		if holeCards.Contains(card) {
+			result.HoleUsed = append(result.HoleUsed, card)
+		} else {
+			result.BoardUsed = append(result.BoardUsed, card)
+		}
+	}
+
+	if v.IsHiLo() {
+		if low, ok := v.bestLow(board, hole); ok {
+			result.Low = &low
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,115 @@
+package poker_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/David-Rushton/card-collection/deck"
+	"github.com/David-Rushton/card-collection/poker"
+)
+
+func Test_Evaluate_ReportsCardsUsed(t *testing.T) {
+	testCases := []struct {
+		board             deck.Hand
+		hole              deck.Hand
+		expectedName      poker.HandName
+		expectedHoleUsed  deck.Hand
+		expectedBoardUsed deck.Hand
+	}{
+		{
+			board:             parseHand("Ah Kh 7c 2d 9s"),
+			hole:              parseHand("Ad 3c"),
+			expectedName:      poker.Pair,
+			expectedHoleUsed:  parseHand("Ad"),
+			expectedBoardUsed: parseHand("Ah Kh 9s 7c"),
+		},
+		{
+			board:             parseHand("Th Jh Qh"),
+			hole:              parseHand("Kh Ah"),
+			expectedName:      poker.RoyalFlush,
+			expectedHoleUsed:  parseHand("Kh Ah"),
+			expectedBoardUsed: parseHand("Th Jh Qh"),
+		},
+		{
+			board:             parseHand("2c 3c 4c 5c 6c"),
+			hole:              parseHand("Ad Kd"),
+			expectedName:      poker.StraightFlush,
+			expectedHoleUsed:  nil,
+			expectedBoardUsed: parseHand("2c 3c 4c 5c 6c"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual, err := poker.Evaluate(testCase.board, testCase.hole)
+		if err != nil {
+			t.Fatalf("❌ Unexpected error: %v.", err)
+		}
+
+		if actual.Name != testCase.expectedName {
+			t.Errorf("❌ Unexpected hand name.  Expected: %v.  Actual: %v.", testCase.expectedName, actual.Name)
+		}
+
+		if !slices.Equal(actual.HoleUsed, testCase.expectedHoleUsed) {
+			t.Errorf("❌ Unexpected hole cards used.  Expected: %v.  Actual: %v.", testCase.expectedHoleUsed.ShortString(), actual.HoleUsed.ShortString())
+		}
+
+		if !slices.Equal(actual.BoardUsed, testCase.expectedBoardUsed) {
+			t.Errorf("❌ Unexpected board cards used.  Expected: %v.  Actual: %v.", testCase.expectedBoardUsed.ShortString(), actual.BoardUsed.ShortString())
+		}
+	}
+}
+
+func Test_Evaluate_MatchesBestHand(t *testing.T) {
+	board := parseHand("9c 9d 4s Jh 2c")
+	hole := parseHand("Js 4d")
+
+	actual, err := poker.Evaluate(board, hole)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	expected := poker.BestHand(append(board, hole...))
+	if actual.Score != expected.Score || actual.Name != expected.Name {
+		t.Errorf("❌ Evaluate disagrees with BestHand.  Expected: %v.  Actual: %v.", expected.Score, actual.Score)
+	}
+}
+
+func Test_Evaluate_ReturnsErrInvalidCardCount(t *testing.T) {
+	testCases := []struct {
+		board         deck.Hand
+		hole          deck.Hand
+		expectedCards string
+	}{
+		{board: parseHand("Ah Kh 7c"), hole: parseHand("2d"), expectedCards: "hole"},
+		{board: parseHand("Ah Kh 7c"), hole: parseHand("2d 3d 4d"), expectedCards: "hole"},
+		{board: parseHand("Ah Kh"), hole: parseHand("2d 3d"), expectedCards: "board"},
+		{board: parseHand("Ah Kh 7c 8c 9c Tc"), hole: parseHand("2d 3d"), expectedCards: "board"},
+	}
+
+	for _, testCase := range testCases {
+		_, err := poker.Evaluate(testCase.board, testCase.hole)
+
+		var invalid poker.ErrInvalidCardCount
+		if !errors.As(err, &invalid) || invalid.Cards != testCase.expectedCards {
+			t.Errorf("❌ Unexpected error.  Expected: ErrInvalidCardCount for %v cards.  Actual: %v.", testCase.expectedCards, err)
+		}
+	}
+}
+
+func Test_Evaluate_ReturnsErrDuplicateCard(t *testing.T) {
+	_, err := poker.Evaluate(parseHand("Ah Kh 7c"), parseHand("Ah 2d"))
+
+	var duplicate deck.ErrDuplicateCard
+	if !errors.As(err, &duplicate) || duplicate.Card != parseHand("Ah")[0] {
+		t.Errorf("❌ Unexpected error.  Expected: ErrDuplicateCard.  Actual: %v.", err)
+	}
+}
+
+func Test_Evaluate_ReturnsErrJokersNotSupported(t *testing.T) {
+	_, err := poker.Evaluate(parseHand("Ah Kh 7c"), deck.Hand{deck.RedJoker, parseHand("2d")[0]})
+
+	if err != poker.ErrJokersNotSupported {
+		t.Errorf("❌ Unexpected error.  Expected: ErrJokersNotSupported.  Actual: %v.", err)
+	}
+}
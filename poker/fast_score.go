@@ -0,0 +1,208 @@
+package poker
+
+import (
+	"math/bits"
+
+	"github.com/David-Rushton/card-collection/deck"
+)
+
+// A faster alternative to BestHand, for simulations that evaluate millions of hands.
+//
+// Cards are held in a deck.CardSet, and hands are identified using bit masks and precomputed
+// tables.  Nothing is allocated.  The name and score match BestHand exactly, but the cards
+// making up the hand are not returned.
+
+var (
+	// The value of the highest straight found in a 13-bit rank mask, indexed by the mask.
+	// Bit 0 is two and bit 12 is ace.  Zero when the mask does not contain a straight.
+	straightTable [1 << 13]int8
+)
+
+func init() {
+	const wheel = 1<<12 | 0b1111
+
+	for mask := range straightTable {
+		// Check the highest straight first.  Ace high is bit 12.
+		for high := 12; high >= 4; high-- {
+			run := 0b11111 << (high - 4)
+			if mask&run == run {
+				straightTable[mask] = int8(high + 2)
+				break
+			}
+		}
+
+		// Ace to five.
+		if straightTable[mask] == 0 && mask&wheel == wheel {
+			straightTable[mask] = 5
+		}
+	}
+}
+
+// Returns the name and score of the best five card hand.
+// The hand must contain between 5 and 7 distinct cards.
+// The result matches BestHand, without allocating.
+func FastScore(hand deck.Hand) (HandName, int64, error) {
+	var cards deck.CardSet
+	for _, card := range hand {
+		if !card.IsValid() {
+			return HighCard, 0, deck.ErrInvalidCard{Input: card.ShortString(), Reason: "Rank or suit out of range"}
+		}
+
+		if cards.Contains(card) {
+			return HighCard, 0, deck.ErrDuplicateCard{Card: card}
+		}
+
+		cards.Add(card)
+	}
+
+	return FastScoreSet(cards)
+}
+
+// Returns the name and score of the best five card hand.
+// The set must contain between 5 and 7 cards.
+// The result matches BestHand, without allocating.
+func FastScoreSet(cards deck.CardSet) (HandName, int64, error) {
+	if count := cards.Count(); count < 5 || count > 7 {
+		return HighCard, 0, ErrInvalidCardCount{Min: 5, Max: 7, Actual: count}
+	}
+
+	if cards.Contains(deck.RedJoker) || cards.Contains(deck.BlackJoker) {
+		return HighCard, 0, ErrJokersNotSupported
+	}
+
+	// ## Section 1 || Analysis
+	// ------------------------
+
+	var suits [4]uint16
+	var ranks uint16
+	var flush uint16
+	for suit := deck.Clubs; suit <= deck.Spades; suit++ {
+		i := suit - deck.Clubs
+		suits[i] = cards.SuitMask(suit)
+		ranks |= suits[i]
+
+		if bits.OnesCount16(suits[i]) >= 5 {
+			flush = suits[i]
+		}
+	}
+
+	// Count how many times each rank appears, by adding the four suit masks together.
+	// Each rank is counted in parallel, with the count held across three masks: 1s, 2s and 4s.
+	clubs, diamonds, hearts, spades := suits[0], suits[1], suits[2], suits[3]
+	carry1, carry2 := clubs&diamonds, hearts&spades
+	sum1, sum2 := clubs^diamonds, hearts^spades
+	ones := sum1 ^ sum2
+	twos := carry1 ^ carry2 ^ (sum1 & sum2)
+	fours := carry1 & carry2
+
+	quadruples := fours
+	trebles := twos & ones
+	pairs := twos &^ ones
+
+	// ## Section 2 || Identify best hand
+	// ----------------------------------
+
+	// royalFlush and straightFlush
+	if flush != 0 {
+		if high := straightTable[flush]; high != 0 {
+			if high == 14 {
+				return RoyalFlush, straightScore(RoyalFlush, high), nil
+			}
+
+			return StraightFlush, straightScore(StraightFlush, high), nil
+		}
+	}
+
+	// fourOfAKind
+	if quadruples != 0 {
+		quad := highest(quadruples)
+		return FourOfAKind, score(FourOfAKind, quad, quad, quad, quad, highest(ranks&^bit(quad))), nil
+	}
+
+	// fullHouse
+	// With seven cards the pair may be taken from a second treble.
+	if trebles != 0 {
+		treble := highest(trebles)
+		if rest := (trebles &^ bit(treble)) | pairs; rest != 0 {
+			pair := highest(rest)
+			return FullHouse, score(FullHouse, treble, treble, treble, pair, pair), nil
+		}
+	}
+
+	// flush
+	if flush != 0 {
+		c1, rest := takeHighest(flush)
+		c2, rest := takeHighest(rest)
+		c3, rest := takeHighest(rest)
+		c4, rest := takeHighest(rest)
+		c5, _ := takeHighest(rest)
+		return Flush, score(Flush, c1, c2, c3, c4, c5), nil
+	}
+
+	// straight
+	if high := straightTable[ranks]; high != 0 {
+		return Straight, straightScore(Straight, high), nil
+	}
+
+	// threeOfAKind
+	if trebles != 0 {
+		treble := highest(trebles)
+		k1, rest := takeHighest(ranks &^ bit(treble))
+		k2, _ := takeHighest(rest)
+		return ThreeOfAKind, score(ThreeOfAKind, treble, treble, treble, k1, k2), nil
+	}
+
+	// twoPairs
+	if bits.OnesCount16(pairs) >= 2 {
+		p1, rest := takeHighest(pairs)
+		p2, _ := takeHighest(rest)
+		kicker := highest(ranks &^ bit(p1) &^ bit(p2))
+		return TwoPairs, score(TwoPairs, p1, p1, p2, p2, kicker), nil
+	}
+
+	// pair
+	if pairs != 0 {
+		pair := highest(pairs)
+		k1, rest := takeHighest(ranks &^ bit(pair))
+		k2, rest := takeHighest(rest)
+		k3, _ := takeHighest(rest)
+		return Pair, score(Pair, pair, pair, k1, k2, k3), nil
+	}
+
+	// highCard
+	c1, rest := takeHighest(ranks)
+	c2, rest := takeHighest(rest)
+	c3, rest := takeHighest(rest)
+	c4, rest := takeHighest(rest)
+	c5, _ := takeHighest(rest)
+	return HighCard, score(HighCard, c1, c2, c3, c4, c5), nil
+}
+
+// Returns the value of the highest rank in a mask.  Two is 2 and ace is 14.
+func highest(mask uint16) int64 {
+	return int64(bits.Len16(mask)) + 1
+}
+
+// Returns the value of the highest rank in a mask, and the mask without it.
+func takeHighest(mask uint16) (int64, uint16) {
+	value := highest(mask)
+	return value, mask &^ bit(value)
+}
+
+// Returns the mask bit for a rank value.
+func bit(value int64) uint16 {
+	return 1 << (value - 2)
+}
+
+// Scores a straight, from its highest card.
+// Matches scoreHand, where straights are held lowest card first.
+func straightScore(name HandName, high int8) int64 {
+	value := int64(high)
+	return score(name, value-4, value-3, value-2, value-1, value)
+}
+
+// Scores five card values, most significant first.
+// Matches scoreHand.
+func score(name HandName, c1, c2, c3, c4, c5 int64) int64 {
+	return (((((int64(name)*100+c1)*100+c2)*100+c3)*100+c4)*100 + c5)
+}
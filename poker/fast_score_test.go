@@ -0,0 +1,161 @@
+package poker_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/David-Rushton/card-collection/deck"
+	"github.com/David-Rushton/card-collection/poker"
+)
+
+// Compares FastScore with BestHand, for every possible five card hand.
+func Test_FastScore_MatchesBestHand_ForEveryFiveCardHand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping exhaustive comparison in short mode.")
+	}
+
+	all := deck.Standard.Cards()
+
+	hand := make(deck.Hand, 5)
+	checked := 0
+	for a := 0; a < 48; a++ {
+		for b := a + 1; b < 49; b++ {
+			for c := b + 1; c < 50; c++ {
+				for d := c + 1; d < 51; d++ {
+					for e := d + 1; e < 52; e++ {
+						hand[0], hand[1], hand[2], hand[3], hand[4] = all[a], all[b], all[c], all[d], all[e]
+						assertFastScoreMatches(t, hand)
+						checked++
+					}
+				}
+			}
+		}
+	}
+
+	if checked != 2_598_960 {
+		t.Errorf("❌ Unexpected number of hands checked.  Expected: 2,598,960.  Actual: %v.", checked)
+	}
+}
+
+// Compares FastScore with BestHand, for a sample of six and seven card hands.
+func Test_FastScore_MatchesBestHand_ForSixAndSevenCardHands(t *testing.T) {
+	d := deck.New(deck.WithSeed(2024))
+
+	for i := 0; i < 100_000; i++ {
+		d.Shuffle()
+		hand, _ := d.Take(6 + i%2)
+		assertFastScoreMatches(t, hand)
+	}
+}
+
+func Test_FastScore_MatchesBestHand_ForEdgeCases(t *testing.T) {
+	testCases := []string{
+		"Ah 2h 3h 4h 5h 6h Kd",
+		"5h 6h 7h 8h 9h Ts Js",
+		"As 2s 3d 4c 5h 6d Kd",
+		"Ac Ad As Kc Kd Ks 2c",
+		"Ac Ad As Kc Kd Qs Qc",
+		"Ac Ad Kc Kd Qs Qc 2c",
+		"2c 3c 4c 5c 7c 9c Jc",
+		"9c 9d 9s 9h Ac Ad As",
+		"Ts Js Qs Ks As 9s 8s",
+	}
+
+	for _, testCase := range testCases {
+		assertFastScoreMatches(t, parseHand(testCase))
+	}
+}
+
+func Test_FastScore_ReturnsError_WhenHandInvalid(t *testing.T) {
+	testCases := []deck.Hand{
+		parseHand("As Ks Qs Js"),
+		parseHand("As Ks Qs Js Ts 9s 8s 7s"),
+		parseHand("As As Qs Js Ts"),
+		append(parseHand("As Ks Qs Js"), deck.RedJoker),
+		append(parseHand("As Ks Qs Js"), deck.Card{}),
+	}
+
+	for _, testCase := range testCases {
+		if _, _, err := poker.FastScore(testCase); err == nil {
+			t.Errorf("❌ Missing error for %v.", testCase.ShortString())
+		}
+	}
+}
+
+func Test_FastScoreSet_DoesNotAllocate(t *testing.T) {
+	cards := deck.NewCardSet(parseHand("As Kd Qc Jh 9h 8d 2c")...)
+
+	allocations := testing.AllocsPerRun(100, func() {
+		poker.FastScoreSet(cards)
+	})
+
+	if allocations != 0 {
+		t.Errorf("❌ Unexpected allocations.  Expected: 0.  Actual: %v.", allocations)
+	}
+}
+
+func Benchmark_BestHand_SevenCards(b *testing.B) {
+	hands := randomHands(1024, 7)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		poker.BestHand(hands[i%len(hands)])
+	}
+}
+
+func Benchmark_FastScore_SevenCards(b *testing.B) {
+	hands := randomHands(1024, 7)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		poker.FastScore(hands[i%len(hands)])
+	}
+}
+
+func Benchmark_FastScoreSet_SevenCards(b *testing.B) {
+	hands := randomHands(1024, 7)
+	sets := make([]deck.CardSet, len(hands))
+	for i, hand := range hands {
+		sets[i] = deck.NewCardSet(hand...)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		poker.FastScoreSet(sets[i%len(sets)])
+	}
+}
+
+func assertFastScoreMatches(t *testing.T, hand deck.Hand) {
+	t.Helper()
+
+	expected := poker.BestHand(hand)
+	name, score, err := poker.FastScore(hand)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error for %v: %v.", hand.ShortString(), err)
+	}
+
+	if name != expected.Name || score != expected.Score {
+		t.Fatalf(
+			"❌ FastScore disagrees with BestHand for %v.  Expected: %v, %v.  Actual: %v, %v.",
+			hand.ShortString(),
+			expected.Name,
+			expected.Score,
+			name,
+			score)
+	}
+}
+
+func randomHands(n, size int) []deck.Hand {
+	d := deck.New(deck.WithSeed(rand.Uint64()))
+
+	result := make([]deck.Hand, n)
+	for i := range result {
+		d.Shuffle()
+		result[i], _ = d.Take(size)
+	}
+
+	return result
+}
@@ -0,0 +1,150 @@
+package poker
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/David-Rushton/card-collection/deck"
+)
+
+// Lowball games, where the lowest hand wins.
+//
+// Each five card hand is ranked as a high hand, and the low score is the inverse of the high
+// score.  The rules differ in how aces, straights and flushes are treated.
+
+const (
+	// Greater than any high score.  Low scores are subtracted from this.
+	lowballCeiling = int64(RoyalFlush+1) * 10_000_000_000
+
+	// The value of an ace, when aces are low.
+	lowAce = 1
+)
+
+// Returns the best deuce-to-seven low, from 5 to 7 cards.
+//
+// Aces are high, and straights and flushes count against the hand.  A-2-3-4-5 is ace high, not a
+// straight.  The best hand is 7-5-4-3-2, of mixed suits.
+func DeuceToSeven(hand deck.Hand) (LowHand, error) {
+	return bestLowball(hand, int64(rankValue(deck.Ace)), true)
+}
+
+// Returns the best ace-to-five low, from 5 to 7 cards.  Used by razz, with seven cards.
+//
+// Aces are low, and straights and flushes are ignored.  The best hand is 5-4-3-2-A.
+func AceToFive(hand deck.Hand) (LowHand, error) {
+	return bestLowball(hand, lowAce, false)
+}
+
+// Checks the cards, and returns the best low from every five card combination.
+func bestLowball(hand deck.Hand, aceValue int64, straights bool) (LowHand, error) {
+	if len(hand) < 5 || len(hand) > 7 {
+		return LowHand{}, ErrInvalidCardCount{Min: 5, Max: 7, Actual: len(hand)}
+	}
+
+	var seen deck.CardSet
+	for _, card := range hand {
+		if card.IsJoker() {
+			return LowHand{}, ErrJokersNotSupported
+		}
+
+		if !card.IsValid() {
+			return LowHand{}, deck.ErrInvalidCard{Input: card.ShortString(), Reason: "Rank or suit out of range"}
+		}
+
+		if seen.Contains(card) {
+			return LowHand{}, deck.ErrDuplicateCard{Card: card}
+		}
+
+		seen.Add(card)
+	}
+
+	var best LowHand
+	for five := range subsets(hand, 5) {
+		low := lowball(five, aceValue, straights)
+		if best.Hand == nil || low.Score > best.Score {
+			best = low
+		}
+	}
+
+	return best, nil
+}
+
+// Scores five cards as a low hand.
+func lowball(hand deck.Hand, aceValue int64, straights bool) LowHand {
+	name, ordered := rankFive(hand, aceValue, straights)
+
+	values := make([]int64, len(ordered))
+	for i, card := range ordered {
+		values[i] = lowballValue(card.Rank, aceValue)
+	}
+
+	high := score(name, values[0], values[1], values[2], values[3], values[4])
+	return LowHand{Name: name, Hand: ordered, Score: lowballCeiling - high}
+}
+
+// Ranks five cards as a high hand.
+// Returns the cards most significant first: the largest group of ranks, then the highest value.
+// Straights and flushes are only found when straights is true.
+func rankFive(hand deck.Hand, aceValue int64, straights bool) (HandName, deck.Hand) {
+	countByRank := make(map[deck.Rank]int)
+	for _, card := range hand {
+		countByRank[card.Rank]++
+	}
+
+	ordered := slices.Clone(hand)
+	slices.SortStableFunc(ordered, func(a, b deck.Card) int {
+		if c := cmp.Compare(countByRank[b.Rank], countByRank[a.Rank]); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(lowballValue(b.Rank, aceValue), lowballValue(a.Rank, aceValue))
+	})
+
+	switch countByRank[ordered[0].Rank] {
+	case 4:
+		return FourOfAKind, ordered
+	case 3:
+		if countByRank[ordered[3].Rank] == 2 {
+			return FullHouse, ordered
+		}
+
+		return ThreeOfAKind, ordered
+	case 2:
+		if countByRank[ordered[2].Rank] == 2 {
+			return TwoPairs, ordered
+		}
+
+		return Pair, ordered
+	}
+
+	if !straights {
+		return HighCard, ordered
+	}
+
+	// Five distinct ranks.  The cards are in descending order.
+	straight := lowballValue(ordered[0].Rank, aceValue)-lowballValue(ordered[4].Rank, aceValue) == 4
+	flush := true
+	for _, card := range ordered {
+		flush = flush && card.Suit == ordered[0].Suit
+	}
+
+	switch {
+	case straight && flush:
+		return StraightFlush, ordered
+	case flush:
+		return Flush, ordered
+	case straight:
+		return Straight, ordered
+	default:
+		return HighCard, ordered
+	}
+}
+
+// Returns the value of a rank, with aces worth aceValue.
+func lowballValue(rank deck.Rank, aceValue int64) int64 {
+	if rank == deck.Ace {
+		return aceValue
+	}
+
+	return rankValue(rank)
+}
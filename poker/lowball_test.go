@@ -0,0 +1,110 @@
+package poker_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/David-Rushton/card-collection/deck"
+	"github.com/David-Rushton/card-collection/poker"
+)
+
+func Test_DeuceToSeven_RanksHands(t *testing.T) {
+	// Best first.
+	testCases := []struct {
+		hand        string
+		description string
+		name        poker.HandName
+	}{
+		{"7s 5d 4h 3c 2s", "7-5-4-3-2", poker.HighCard},
+		{"8s 6d 4h 3c 2s", "8-6-4-3-2", poker.HighCard},
+		{"Ks Qd Jh 9c 8s", "K-Q-J-9-8", poker.HighCard},
+		{"As 2d 3h 4c 5s", "A-5-4-3-2", poker.HighCard},
+		{"2s 2d 4h 3c 5s", "2-2-5-4-3", poker.Pair},
+		{"9s 9d 6h 4c 3s", "9-9-6-4-3", poker.Pair},
+		{"6s 5d 4h 3c 2s", "6-5-4-3-2", poker.Straight},
+		{"8h 5h 4h 3h 2h", "8-5-4-3-2", poker.Flush},
+		{"6h 5h 4h 3h 2h", "6-5-4-3-2", poker.StraightFlush},
+	}
+
+	assertLowRanking(t, poker.DeuceToSeven, testCases)
+}
+
+func Test_AceToFive_RanksHands(t *testing.T) {
+	// Best first.  Straights and flushes do not count.
+	testCases := []struct {
+		hand        string
+		description string
+		name        poker.HandName
+	}{
+		{"5s 4d 3h 2c As", "5-4-3-2-A", poker.HighCard},
+		{"6h 4h 3h 2h Ah", "6-4-3-2-A", poker.HighCard},
+		{"6s 5d 4h 3c 2s", "6-5-4-3-2", poker.HighCard},
+		{"Ks Qd Jh Tc 9s", "K-Q-J-T-9", poker.HighCard},
+		{"As Ad 4h 3c 2s", "A-A-4-3-2", poker.Pair},
+		{"9s 9d 4h 3c 2s", "9-9-4-3-2", poker.Pair},
+		{"3s 3d 2h 2c As", "3-3-2-2-A", poker.TwoPairs},
+	}
+
+	assertLowRanking(t, poker.AceToFive, testCases)
+}
+
+func Test_AceToFive_ChoosesBestFiveOfSeven(t *testing.T) {
+	// A razz hand.  The kings are discarded.
+	actual, err := poker.AceToFive(parseHand("Kc Kd 7s 5d 3h 2c As"))
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if actual.String() != "7-5-3-2-A" {
+		t.Errorf("❌ Unexpected low.  Expected: 7-5-3-2-A.  Actual: %v.", actual)
+	}
+
+	// Deuce-to-seven avoids the straight, by keeping the eight.
+	actual, _ = poker.DeuceToSeven(parseHand("8c 6d 5s 4d 3h 2c"))
+	if actual.String() != "8-5-4-3-2" {
+		t.Errorf("❌ Unexpected low.  Expected: 8-5-4-3-2.  Actual: %v.", actual)
+	}
+}
+
+func Test_Lowball_ReturnsError_WhenCardsInvalid(t *testing.T) {
+	var invalidCount poker.ErrInvalidCardCount
+	if _, err := poker.DeuceToSeven(parseHand("7s 5d 4h 3c")); !errors.As(err, &invalidCount) {
+		t.Errorf("❌ Expected ErrInvalidCardCount.  Actual: %v.", err)
+	}
+
+	if _, err := poker.AceToFive(append(parseHand("5d 4h 3c 2s"), deck.RedJoker)); !errors.Is(err, poker.ErrJokersNotSupported) {
+		t.Errorf("❌ Expected ErrJokersNotSupported.  Actual: %v.", err)
+	}
+
+	var duplicate deck.ErrDuplicateCard
+	if _, err := poker.AceToFive(parseHand("5d 5d 4h 3c 2s")); !errors.As(err, &duplicate) {
+		t.Errorf("❌ Expected ErrDuplicateCard.  Actual: %v.", err)
+	}
+}
+
+func assertLowRanking(t *testing.T, evaluate func(deck.Hand) (poker.LowHand, error), testCases []struct {
+	hand        string
+	description string
+	name        poker.HandName
+}) {
+	t.Helper()
+
+	var previous poker.LowHand
+	for i, testCase := range testCases {
+		actual, err := evaluate(parseHand(testCase.hand))
+		if err != nil {
+			t.Errorf("❌ Unexpected error for %v: %v.", testCase.hand, err)
+			continue
+		}
+
+		if actual.String() != testCase.description || actual.Name != testCase.name {
+			t.Errorf("❌ Unexpected low for %v.  Expected: %v %v.  Actual: %v %v.", testCase.hand, testCase.name, testCase.description, actual.Name, actual)
+		}
+
+		if i > 0 && actual.Score >= previous.Score {
+			t.Errorf("❌ Expected %v to rank below %v.", testCase.description, previous)
+		}
+
+		previous = actual
+	}
+}
@@ -0,0 +1,133 @@
+package poker
+
+import (
+	"iter"
+	"slices"
+	"strings"
+
+	"github.com/David-Rushton/card-collection/deck"
+)
+
+const (
+	// The highest rank allowed in an eight or better low.
+	lowQualifier = 8
+)
+
+// A low hand, for games where the lowest cards win.
+type LowHand struct {
+	// The high hand the cards make.  Pairs, and in some games straights and flushes, count against
+	// a low.
+	Name HandName
+
+	// The five cards, most significant first.  Pairs come before single cards.
+	Hand deck.Hand
+
+	// Bigger is better.  Compare low hands with the same rules only.
+	Score int64
+}
+
+// Returns the ranks, most significant first, separated by dashes.  Example: 8-6-4-3-A.
+func (l LowHand) String() string {
+	ranks := make([]string, len(l.Hand))
+	for i, card := range l.Hand {
+		ranks[i] = card.ShortString()[:1]
+	}
+
+	return strings.Join(ranks, "-")
+}
+
+// Returns the best high hand the variant allows.
+// Variants that must use an exact number of hole cards check every combination.
+func (v Variant) bestHand(board, hole deck.Hand) (HandName, deck.Hand) {
+	if v.isShortDeck() {
+		return v.bestShortDeckHand(slices.Concat(board, hole))
+	}
+
+	holeUsed := v.cardCounts().holeUsed
+	if holeUsed == 0 {
+		return getBestHand(board, hole)
+	}
+
+	var bestName HandName
+	var best deck.Hand
+	bestScore := int64(-1)
+	for holePart := range subsets(hole, holeUsed) {
+		for boardPart := range subsets(board, 5-holeUsed) {
+			name, hand := getBestHand(boardPart, holePart)
+			if score := scoreHand(name, hand); score > bestScore {
+				bestName, best, bestScore = name, hand, score
+			}
+		}
+	}
+
+	return bestName, best
+}
+
+// Returns the best eight or better low the variant allows.
+// Aces are low.  A low needs five different ranks, eight or under.  Straights and flushes do not
+// count against a low.
+// Returns false when there is no qualifying low.
+func (v Variant) bestLow(board, hole deck.Hand) (LowHand, bool) {
+	holeUsed := v.cardCounts().holeUsed
+
+	var best LowHand
+	found := false
+	for holePart := range subsets(hole, holeUsed) {
+		for boardPart := range subsets(board, 5-holeUsed) {
+			low, ok := eightOrBetter(slices.Concat(holePart, boardPart))
+			if ok && (!found || low.Score > best.Score) {
+				best, found = low, true
+			}
+		}
+	}
+
+	return best, found
+}
+
+// Scores five cards as an eight or better, ace-to-five low.
+// Returns false when the cards do not qualify.
+func eightOrBetter(hand deck.Hand) (LowHand, bool) {
+	low := lowball(hand, lowAce, false)
+	if low.Name != HighCard || low.Hand[0].Rank > lowQualifier {
+		return LowHand{}, false
+	}
+
+	return low, true
+}
+
+// Iterates over every combination of k cards from the hand, in order.
+// Each combination is a new hand, which may be kept.
+func subsets(hand deck.Hand, k int) iter.Seq[deck.Hand] {
+	return func(yield func(deck.Hand) bool) {
+		indexes := make([]int, k)
+		for i := range indexes {
+			indexes[i] = i
+		}
+
+		for k <= len(hand) {
+			subset := make(deck.Hand, k)
+			for i, index := range indexes {
+				subset[i] = hand[index]
+			}
+
+			if !yield(subset) {
+				return
+			}
+
+			// Advance the rightmost index that has room to move.
+			i := k - 1
+			for i >= 0 && indexes[i] == len(hand)-k+i {
+				i--
+			}
+
+			if i < 0 {
+				return
+			}
+
+			indexes[i]++
+			for j := i + 1; j < k; j++ {
+				indexes[j] = indexes[j-1] + 1
+			}
+		}
+	}
+}
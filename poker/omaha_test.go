@@ -0,0 +1,134 @@
+package poker_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/David-Rushton/card-collection/deck"
+	"github.com/David-Rushton/card-collection/poker"
+)
+
+func Test_Omaha_UsesExactlyTwoHoleCards(t *testing.T) {
+	board := parseHand("Ah Kh Qh Jh 2c")
+	hole := parseHand("Th 9c 9d 3s")
+
+	holdem, _ := poker.Evaluate(board, hole[:2])
+	if holdem.Name != poker.RoyalFlush {
+		t.Fatalf("❌ Expected a royal flush in hold'em.  Actual: %v.", holdem.Name)
+	}
+
+	// The ten of hearts cannot play alone.  T 9 with K Q J makes a straight.
+	actual, err := poker.Omaha.Evaluate(board, hole)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if actual.Name != poker.Straight {
+		t.Errorf("❌ Unexpected hand.  Expected: %v.  Actual: %v.", poker.Straight, actual.Name)
+	}
+
+	if len(actual.HoleUsed) != 2 || len(actual.BoardUsed) != 3 {
+		t.Errorf("❌ Expected 2 hole and 3 board cards.  Actual: %v and %v.", actual.HoleUsed, actual.BoardUsed)
+	}
+
+	if actual.Low != nil {
+		t.Errorf("❌ Omaha high should not have a low.  Actual: %v.", actual.Low)
+	}
+}
+
+func Test_Omaha_EvaluatesEachHoleCount(t *testing.T) {
+	board := parseHand("2c 7d 9h")
+	testCases := []struct {
+		variant  poker.Variant
+		hole     deck.Hand
+		expected poker.HandName
+	}{
+		{poker.Omaha, parseHand("9c 9d 3s 4s"), poker.ThreeOfAKind},
+		{poker.Omaha5, parseHand("As Ks 9c 7c 3h"), poker.TwoPairs},
+		{poker.Omaha6, parseHand("As Ks 8c Jc 3h 2d"), poker.Pair},
+	}
+
+	for _, testCase := range testCases {
+		actual, err := testCase.variant.Evaluate(board, testCase.hole)
+		if err != nil {
+			t.Errorf("❌ Unexpected error for %v: %v.", testCase.hole, err)
+			continue
+		}
+
+		if actual.Name != testCase.expected {
+			t.Errorf("❌ Unexpected hand for %v.  Expected: %v.  Actual: %v.", testCase.hole, testCase.expected, actual.Name)
+		}
+	}
+
+	var invalidCount poker.ErrInvalidCardCount
+	if _, err := poker.Omaha5.Evaluate(board, parseHand("As Ks 8c Tc")); !errors.As(err, &invalidCount) {
+		t.Errorf("❌ Expected ErrInvalidCardCount.  Actual: %v.", err)
+	}
+}
+
+func Test_OmahaHiLo_FindsEightOrBetterLow(t *testing.T) {
+	actual, err := poker.OmahaHiLo.Evaluate(parseHand("2c 5d 8h Kc Qs"), parseHand("Ah 3d Ks 9h"))
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if actual.Low == nil || actual.Low.String() != "8-5-3-2-A" {
+		t.Errorf("❌ Unexpected low.  Expected: 8-5-3-2-A.  Actual: %v.", actual.Low)
+	}
+
+	// Only the two is low enough on this board.
+	actual, _ = poker.OmahaHiLo.Evaluate(parseHand("2c Kc Qs Jd 9h"), parseHand("Ah 3d Ks 9c"))
+	if actual.Low != nil {
+		t.Errorf("❌ Expected no low.  Actual: %v.", actual.Low)
+	}
+}
+
+func Test_HiLoShowdown_SplitsAndQuarters(t *testing.T) {
+	board := parseHand("2c 5d 8h Kc Qs")
+	players := map[string]deck.Hand{
+		"alice": parseHand("Ah 3d Kd Ks"),
+		"bob":   parseHand("As 3h 7c 7d"),
+		"cara":  parseHand("Qc Qd Jc Jd"),
+	}
+
+	// Alice's three kings beat cara's three queens.  Alice and bob share the low.
+	actual, err := poker.OmahaHiLo.HiLoShowdown(board, players)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if winners := actual.High.Winners(); len(winners) != 1 || winners[0] != "alice" {
+		t.Errorf("❌ Unexpected high winners: %v.", winners)
+	}
+
+	if len(actual.Low) != 1 || !actual.Low.IsSplitPot() {
+		t.Errorf("❌ Expected alice and bob to tie for low.  Actual: %v.", actual.Low)
+	}
+
+	expected := map[string]float64{"alice": 0.75, "bob": 0.25}
+	if len(actual.Shares) != len(expected) {
+		t.Errorf("❌ Unexpected shares: %v.", actual.Shares)
+	}
+
+	for player, share := range expected {
+		assertEquity(t, actual.Shares[player], share)
+	}
+}
+
+func Test_HiLoShowdown_ScoopsWithoutLow(t *testing.T) {
+	players := map[string]deck.Hand{
+		"alice": parseHand("Ah 3d Kd Ks"),
+		"bob":   parseHand("As 3h 7c 7d"),
+	}
+
+	actual, err := poker.OmahaHiLo.HiLoShowdown(parseHand("2c Kc Qs Jd 9h"), players)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if len(actual.Low) != 0 || len(actual.Shares) != 1 {
+		t.Errorf("❌ Expected alice to scoop.  Actual: %v.", actual.Shares)
+	}
+
+	assertEquity(t, actual.Shares["alice"], 1)
+}
@@ -0,0 +1,198 @@
+package poker
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/David-Rushton/card-collection/deck"
+)
+
+// An unseen card that improves a player's hand.
+type Out struct {
+	Card deck.Card
+
+	// The hand the card makes.
+	Name HandName
+
+	// False when the card also improves an opponent's hand.
+	Clean bool
+}
+
+// A player's drawing odds.
+type OutsResult struct {
+	// The hand the player holds now.
+	Current HandName
+
+	// Every card that improves the hand, best improvement first.
+	Outs []Out
+
+	// The number of cards that could still be dealt.
+	Unseen int
+
+	// The chance of hitting at least one out by the river.
+	Probability float64
+
+	// The chance of hitting at least one clean out by the river.
+	CleanProbability float64
+}
+
+// Returns the outs that do not improve an opponent's hand.
+func (r OutsResult) Clean() []Out {
+	var result []Out
+	for _, out := range r.Outs {
+		if out.Clean {
+			result = append(result, out)
+		}
+	}
+
+	return result
+}
+
+// Returns the Texas hold'em outs for a player, on the flop or turn.
+// See Variant.Outs.
+func Outs(board, hole deck.Hand, opponents ...deck.Hand) (OutsResult, error) {
+	return Holdem.Outs(board, hole, opponents...)
+}
+
+// Returns every unseen card that improves the player's hand, and the chance of hitting one by
+// the river.
+//
+// A card improves a hand when it makes a better HandName than both the player's current hand, and
+// the board on its own.  Hand names are compared using the variant's ranking.  Cards that only
+// improve the board are not outs, as every player shares them.  An out is clean when it does not
+// improve any of the opponents' known hands.
+//
+// Returns ErrInvalidCardCount when the board is complete, or the variant does not allow the number
+// of cards, and deck.ErrDuplicateCard when a card is held by more than one player.
+func (v Variant) Outs(board, hole deck.Hand, opponents ...deck.Hand) (OutsResult, error) {
+	counts := v.cardCounts()
+	if len(board) < counts.minBoard || len(board) >= counts.maxBoard {
+		return OutsResult{}, ErrInvalidCardCount{Cards: "board", Min: counts.minBoard, Max: counts.maxBoard - 1, Actual: len(board)}
+	}
+
+	current, err := v.Evaluate(board, hole)
+	if err != nil {
+		return OutsResult{}, err
+	}
+
+	known := deck.NewCardSet(board...).Union(deck.NewCardSet(hole...))
+	opponentHands := make([]HandName, len(opponents))
+	for i, opponent := range opponents {
+		evaluation, err := v.Evaluate(board, opponent)
+		if err != nil {
+			return OutsResult{}, err
+		}
+
+		for _, card := range opponent {
+			if known.Contains(card) {
+				return OutsResult{}, deck.ErrDuplicateCard{Card: card}
+			}
+
+			known.Add(card)
+		}
+
+		opponentHands[i] = evaluation.Name
+	}
+
+	result := OutsResult{Current: current.Name}
+	for _, card := range v.Composition().Cards() {
+		if known.Contains(card) {
+			continue
+		}
+
+		result.Unseen++
+
+		next := board.Append(deck.Hand{card}, 1)
+		shared := v.boardHand(next)
+		name, ok := v.improves(next, hole, v.stronger(current.Name, shared))
+		if !ok {
+			continue
+		}
+
+		out := Out{Card: card, Name: name, Clean: true}
+		for i, opponent := range opponents {
+			if _, ok := v.improves(next, opponent, v.stronger(opponentHands[i], shared)); ok {
+				out.Clean = false
+				break
+			}
+		}
+
+		result.Outs = append(result.Outs, out)
+	}
+
+	slices.SortStableFunc(result.Outs, func(a, b Out) int {
+		return cmp.Compare(v.handRank(b.Name), v.handRank(a.Name))
+	})
+
+	draws := counts.maxBoard - len(board)
+	result.Probability = hitProbability(len(result.Outs), result.Unseen, draws)
+	result.CleanProbability = hitProbability(len(result.Clean()), result.Unseen, draws)
+
+	return result, nil
+}
+
+// Returns the hand made from the board and hole cards, if it beats the current hand.
+func (v Variant) improves(board, hole deck.Hand, current HandName) (HandName, bool) {
+	evaluation, err := v.Evaluate(board, hole)
+	if err != nil || v.handRank(evaluation.Name) <= v.handRank(current) {
+		return current, false
+	}
+
+	return evaluation.Name, true
+}
+
+// Returns the hand the board makes on its own.
+// Boards of fewer than five cards can only make pairs, trebles and quadruples.
+//
+// Omaha hands use exactly three board cards, so the board alone never makes more than trebles.
+func (v Variant) boardHand(board deck.Hand) HandName {
+	omaha := v.cardCounts().holeUsed > 0
+	if len(board) >= 5 && !omaha {
+		// Any number of board cards may be used.
+		variant := Holdem
+		if v.isShortDeck() {
+			variant = v
+		}
+
+		name, _ := variant.bestHand(board, deck.Hand{})
+		return name
+	}
+
+	countByRank := make(map[deck.Rank]int)
+	pairs := 0
+	result := HighCard
+	for _, card := range board {
+		countByRank[card.Rank]++
+		switch countByRank[card.Rank] {
+		case 2:
+			pairs++
+			result = max(result, min(HandName(pairs), TwoPairs))
+		case 3:
+			result = max(result, ThreeOfAKind)
+		case 4:
+			result = FourOfAKind
+		}
+	}
+
+	if omaha {
+		// Three board cards hold one pair, or trebles.
+		switch result {
+		case TwoPairs:
+			result = Pair
+		case FourOfAKind:
+			result = ThreeOfAKind
+		}
+	}
+
+	return result
+}
+
+// Returns the chance that at least one of the outs is among the cards drawn.
+func hitProbability(outs, unseen, draws int) float64 {
+	if outs == 0 || unseen == 0 {
+		return 0
+	}
+
+	misses := combinations(unseen-outs, draws)
+	return 1 - float64(misses)/float64(combinations(unseen, draws))
+}
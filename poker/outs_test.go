@@ -0,0 +1,143 @@
+package poker_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/David-Rushton/card-collection/deck"
+	"github.com/David-Rushton/card-collection/poker"
+)
+
+func Test_Outs_FindsFlushDraw(t *testing.T) {
+	// Nine hearts make a flush.  Three aces and three nines make a pair.  Pairing the board is not an
+	// improvement, as it helps every player.
+	actual, err := poker.Outs(parseHand("Kh 7h 2c"), parseHand("Ah 9h"))
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if actual.Current != poker.HighCard || actual.Unseen != 47 {
+		t.Errorf("❌ Unexpected current hand or unseen count.  Actual: %v, %v.", actual.Current, actual.Unseen)
+	}
+
+	counts := make(map[poker.HandName]int)
+	for _, out := range actual.Outs {
+		counts[out.Name]++
+	}
+
+	if len(actual.Outs) != 15 || counts[poker.Flush] != 9 || counts[poker.Pair] != 6 {
+		t.Errorf("❌ Unexpected outs: %v.", actual.Outs)
+	}
+
+	if actual.Outs[0].Name != poker.Flush {
+		t.Errorf("❌ Expected the best improvements first.  Actual: %v.", actual.Outs[0])
+	}
+
+	// Misses both the turn and river: 32 choose 2, from 47 choose 2.
+	assertEquity(t, actual.Probability, 1-496.0/1081)
+	assertEquity(t, actual.CleanProbability, actual.Probability)
+}
+
+func Test_Outs_SeparatesCleanOuts(t *testing.T) {
+	// The queen and two of hearts make the flush, but also give the opponent two pairs.
+	actual, err := poker.Outs(parseHand("Kh 7h 2c"), parseHand("Ah 9h"), parseHand("Kc Qd"))
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	clean := actual.Clean()
+	if len(actual.Outs) != 15 || len(clean) != 13 {
+		t.Fatalf("❌ Expected 15 outs, 13 clean.  Actual: %v, %v.", len(actual.Outs), len(clean))
+	}
+
+	dirty := deck.NewCardSet(parseHand("Qh 2h")...)
+	for _, out := range actual.Outs {
+		if dirty.Contains(out.Card) == out.Clean {
+			t.Errorf("❌ Unexpected clean flag for %v.", out.Card)
+		}
+	}
+
+	// The opponent's cards are no longer unseen.
+	assertEquity(t, actual.Probability, 1-float64(30*29)/float64(45*44))
+	assertEquity(t, actual.CleanProbability, 1-float64(32*31)/float64(45*44))
+}
+
+func Test_Outs_OnTheTurn(t *testing.T) {
+	// Eight cards complete the straight, and six pair a hole card.
+	actual, err := poker.Outs(parseHand("6d 7s Kh 2c"), parseHand("8c 9c"))
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	straights := 0
+	for _, out := range actual.Outs {
+		if out.Name == poker.Straight {
+			straights++
+		}
+	}
+
+	if len(actual.Outs) != 14 || straights != 8 {
+		t.Errorf("❌ Unexpected outs: %v.", actual.Outs)
+	}
+
+	assertEquity(t, actual.Probability, 14.0/46)
+}
+
+func Test_Outs_Omaha_CountsStraightsTheBoardCannotMake(t *testing.T) {
+	// Nines make 7-8-9 with ten-jack.  The board's own straight does not play, as Omaha uses exactly
+	// two hole cards.
+	actual, err := poker.Omaha.Outs(parseHand("5c 6d 7h 8s"), parseHand("Td Jc Kh Kd"))
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	nines := 0
+	for _, out := range actual.Outs {
+		if out.Card.Rank == deck.Nine && out.Name == poker.Straight {
+			nines++
+		}
+	}
+
+	if nines != 4 {
+		t.Errorf("❌ Expected four nines to make a straight.  Actual: %v.", actual.Outs)
+	}
+}
+
+func Test_Outs_ShortDeck_OrdersOutsByVariantRanking(t *testing.T) {
+	// Five spades make a flush and four cards fill the house.  In short deck the flush ranks higher.
+	actual, err := poker.ShortDeck.Outs(parseHand("9s 9h 7s Ks"), parseHand("Ts Th"))
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	counts := make(map[poker.HandName]int)
+	for _, out := range actual.Outs {
+		counts[out.Name]++
+	}
+
+	if counts[poker.Flush] != 5 || counts[poker.FullHouse] != 4 {
+		t.Fatalf("❌ Unexpected outs: %v.", actual.Outs)
+	}
+
+	for i, out := range actual.Outs[:5] {
+		if out.Name != poker.Flush {
+			t.Errorf("❌ Expected flush outs first.  Position: %d.  Actual: %v.", i, out)
+		}
+	}
+}
+
+func Test_Outs_ReturnsError_WhenCardsInvalid(t *testing.T) {
+	var invalidCount poker.ErrInvalidCardCount
+	if _, err := poker.Outs(parseHand("6d 7s Kh 2c 3c"), parseHand("8c 9c")); !errors.As(err, &invalidCount) {
+		t.Errorf("❌ Expected ErrInvalidCardCount on the river.  Actual: %v.", err)
+	}
+
+	if _, err := poker.Outs(parseHand("6d 7s"), parseHand("8c 9c")); !errors.As(err, &invalidCount) {
+		t.Errorf("❌ Expected ErrInvalidCardCount before the flop.  Actual: %v.", err)
+	}
+
+	var duplicate deck.ErrDuplicateCard
+	if _, err := poker.Outs(parseHand("6d 7s Kh"), parseHand("8c 9c"), parseHand("9c Ad")); !errors.As(err, &duplicate) {
+		t.Errorf("❌ Expected ErrDuplicateCard.  Actual: %v.", err)
+	}
+}
@@ -29,7 +29,11 @@ const (
 	RoyalFlush
 )
 
+// Returns the best hand that can be made from the cards.
+// Jokers are not wild, and are ignored.  Use Evaluate to reject hands that contain jokers.
 func BestHand(hand deck.Hand) PokerHand {
+	hand = slices.DeleteFunc(slices.Clone(hand), deck.Card.IsJoker)
+
 	handName, hand := getBestHand(deck.Hand{}, hand)
 	handScore := scoreHand(handName, hand)
 
@@ -99,8 +103,8 @@ func getBestHand(board, players deck.Hand) (HandName, deck.Hand) {
 	var pairs []deck.Rank
 	var trebles []deck.Rank
 	var quadruples []deck.Rank
-	for k, v := range countByRank {
-		switch v {
+	for _, k := range sortedRanks {
+		switch countByRank[k] {
 		case 4:
 			quadruples = slices.Insert(quadruples, 0, k)
 		case 3:
@@ -112,62 +116,34 @@ func getBestHand(board, players deck.Hand) (HandName, deck.Hand) {
 
 	// Find consecutive cards.
 	// 5 or more is a straight.
-	// Use the favoured suite, from earlier, to ensure straight flushes are found.
-
-	// Aces are high and low.
-	if sortedRanks[len(sortedRanks)-1] == deck.Ace {
-		sortedRanks = slices.Concat([]deck.Rank{deck.Ace}, sortedRanks)
-	}
-
-	var lastRank deck.Rank
-	consecutiveSuits := make(map[deck.Suit]int)
-	consecutiveCards := deck.Hand{}
-	for i, k := range sortedRanks {
-		v := cardsByRank[k]
-
-		// Last card is one higher than the previous.
-		if lastRank == 0 || k == lastRank+1 || (lastRank == deck.King && k == deck.Ace) {
-			nextCard := takeFirstFavouringSuit(v, favourSuit)
-			consecutiveCards = append(consecutiveCards, nextCard)
-			consecutiveSuits[nextCard.Suit]++
-			lastRank = k
-			continue
-		}
-
-		// Check if it is possible to find a better straight, or indeed one at all.
-		if len(sortedRanks)-i < 5 {
-			break
-		}
+	// Straight flushes are found by searching the favoured suit, from earlier, on its own.
+	consecutiveCards := findStraight(sortedHand)
 
-		// Not consecutive.  Reset.
-		consecutiveCards = deck.Hand{takeFirstFavouringSuit(v, favourSuit)}
-		consecutiveSuits = map[deck.Suit]int{
-			consecutiveCards[0].Suit: 1,
-		}
-		lastRank = k
-	}
+	var flushCards deck.Hand
+	var straightFlushCards deck.Hand
+	if countBySuit[favourSuit] >= 5 {
+		flushCards = cardsBySuit[favourSuit]
+		straightFlushCards = findStraight(flushCards)
 
-	// The final five are the highest value.
-	if len(consecutiveCards) > 5 {
-		consecutiveCards = consecutiveCards[len(consecutiveCards)-5:]
+		// The final five are the highest value.
+		flushCards = flushCards[len(flushCards)-5:]
 	}
 
 	// Place the highest value cards at the start, making them easier to access.
-	kickers := sortedHand
+	kickers := slices.Clone(sortedHand)
 	slices.Reverse(kickers)
 
 	// ## Section 2 || Identify best hand
 	// ----------------------------------
 
 	// royalFlush
-	if len(consecutiveCards) == 5 &&
-		len(consecutiveSuits) == 1 &&
-		consecutiveCards[len(consecutiveCards)-1].Rank == deck.Ace {
-		return RoyalFlush, consecutiveCards
+	if len(straightFlushCards) == 5 && straightFlushCards[4].Rank == deck.Ace {
+		return RoyalFlush, straightFlushCards
 	}
+
 	// straightFlush
-	if len(consecutiveCards) == 5 && len(consecutiveSuits) == 1 {
-		return StraightFlush, consecutiveCards
+	if len(straightFlushCards) == 5 {
+		return StraightFlush, straightFlushCards
 	}
 
 	// fourOfAKind
@@ -176,13 +152,18 @@ func getBestHand(board, players deck.Hand) (HandName, deck.Hand) {
 	}
 
 	// fullHouse
+	// With seven cards the pair may be taken from a second treble.
+	if len(trebles) > 1 && (len(pairs) == 0 || rankValue(trebles[1]) > rankValue(pairs[0])) {
+		pairs = slices.Insert(pairs, 0, trebles[1])
+	}
+
 	if len(trebles) > 0 && len(pairs) > 0 {
-		return FullHouse, slices.Concat(cardsByRank[trebles[0]], cardsByRank[pairs[0]])
+		return FullHouse, slices.Concat(cardsByRank[trebles[0]], cardsByRank[pairs[0]].Take(2))
 	}
 
 	// flush
-	if countBySuit[favourSuit] >= 5 {
-		return Flush, cardsBySuit[favourSuit]
+	if len(flushCards) == 5 {
+		return Flush, flushCards
 	}
 
 	// straight
@@ -196,12 +177,12 @@ func getBestHand(board, players deck.Hand) (HandName, deck.Hand) {
 	}
 
 	// twoPairs
-	if len(pairs) == 2 {
+	if len(pairs) >= 2 {
 		return TwoPairs, addKickers(slices.Concat(cardsByRank[pairs[0]], cardsByRank[pairs[1]]), kickers)
 	}
 
 	// pair
-	if len(pairs) == 1 {
+	if len(pairs) > 0 {
 		return Pair, addKickers(cardsByRank[pairs[0]], kickers)
 	}
 
@@ -209,28 +190,78 @@ func getBestHand(board, players deck.Hand) (HandName, deck.Hand) {
 	return HighCard, kickers[0:5]
 }
 
-// Takes the first card from a hand, that matches the favoured suit.
-// If no match we return the first card.
-func takeFirstFavouringSuit(hand deck.Hand, suit deck.Suit) deck.Card {
-	for _, card := range hand {
-		// Take the first card that matces the favoured suit.
-		if card.Suit == suit {
-			return card
+// Returns the highest five consecutive cards, lowest first.
+// Returns an empty hand when there is no straight.
+// The hand must be sorted by rank.
+func findStraight(sortedHand deck.Hand) deck.Hand {
+	// Aces are high and low.
+	// An ace at the end of the hand is also considered before the two.
+	cards := sortedHand
+	if len(cards) > 0 && cards[len(cards)-1].Rank == deck.Ace {
+		cards = slices.Concat(deck.Hand{cards[len(cards)-1]}, cards)
+	}
+
+	var consecutiveCards deck.Hand
+	var best deck.Hand
+	for _, card := range cards {
+		if len(consecutiveCards) > 0 {
+			lastRank := consecutiveCards[len(consecutiveCards)-1].Rank
+
+			// Same rank as the previous card.  Skip the duplicate.
+			if card.Rank == lastRank {
+				continue
+			}
+
+			// Not consecutive.  Reset.
+			if card.Rank != lastRank+1 && !(lastRank == deck.King && card.Rank == deck.Ace) {
+				consecutiveCards = nil
+			}
 		}
+
+		consecutiveCards = append(consecutiveCards, card)
+
+		// Later runs are higher value.
+		if len(consecutiveCards) >= 5 {
+			best = consecutiveCards[len(consecutiveCards)-5:]
+		}
+	}
+
+	return best
+}
+
+// Returns the value of a rank.  Aces are high.
+func rankValue(rank deck.Rank) int64 {
+	if rank == deck.Ace {
+		return int64(deck.King) + 1
 	}
 
-	// There were no matches, return the first card.
-	return hand[0]
+	return int64(rank)
 }
 
 // Pads the given hand with kickers.
 func addKickers(hand deck.Hand, kickers deck.Hand) deck.Hand {
 	required := 5 - len(hand)
+	used := deck.NewCardSet(hand...)
 	return hand.AppendWhen(kickers, required, func(c deck.Card) bool {
-		return !slices.Contains(hand, c)
+		return !used.Contains(c)
 	})
 }
 
+// Returns the first card that appears more than once.
+// Returns the zero card when every card is distinct.
+func firstDuplicate(hand deck.Hand) deck.Card {
+	var seen deck.CardSet
+	for _, card := range hand {
+		if seen.Contains(card) {
+			return card
+		}
+
+		seen.Add(card)
+	}
+
+	return deck.Card{}
+}
+
 // Scores a hand.
 // Bigger is better.
 func scoreHand(HandType HandName, hand deck.Hand) int64 {
@@ -257,18 +288,25 @@ func scoreHand(HandType HandName, hand deck.Hand) int64 {
 		log.Fatalf("Cannot score hand.  The hand contains too many cards.  Expected 5 but found %v.", len(hand))
 	}
 
-	toScore := func(rank deck.Rank) int64 {
-		if rank == deck.Ace {
-			return int64(deck.King) + 1
-		}
-
-		return int64(rank)
+	// Flushes are held lowest card first.  The highest card is the most significant.
+	if HandType == Flush {
+		hand = slices.Clone(hand)
+		slices.Reverse(hand)
 	}
 
+	// Straights are held lowest card first.  A straight starting with an ace is five high, so the
+	// ace is worth 1.
+	lowAce := (HandType == Straight || HandType == StraightFlush) && hand[0].Rank == deck.Ace
+
 	var score int64
 	multiplier := int64(1)
 	for i := len(hand) - 1; i >= 0; i-- {
-		score += toScore(hand[i].Rank) * multiplier
+		value := rankValue(hand[i].Rank)
+		if i == 0 && lowAce {
+			value = 1
+		}
+
+		score += value * multiplier
 		multiplier *= 100
 	}
 
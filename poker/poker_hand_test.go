@@ -2,7 +2,6 @@ package poker_test
 
 import (
 	"slices"
-	"strconv"
 	"strings"
 	"testing"
 
@@ -345,11 +344,21 @@ func Test_ScoreHand_CorrectlyRanksHands(t *testing.T) {
 			worst:       parseHand("4s 5c 6s 7s 8s"),
 			description: "Straight should outrank worse Straight",
 		},
+		{
+			best:        parseHand("2c 3d 4h 5s 6c"),
+			worst:       parseHand("Ac 2d 3h 4s 5c"),
+			description: "Six high straight should outrank five high straight",
+		},
 		{
 			best:        parseHand("3s 4s 7s Ks Qs"),
 			worst:       parseHand("5c 6s 7s 8s 9s"),
 			description: "Flush should outrank straight",
 		},
+		{
+			best:        parseHand("2s 9s Ts Js Ks"),
+			worst:       parseHand("3s 4s 5s 6s 8s"),
+			description: "Flush should be ranked by its highest card",
+		},
 		{
 			best:        parseHand("3s 4s 7s Ks As"),
 			worst:       parseHand("3s 4s 7s Ks Qs"),
@@ -405,6 +414,66 @@ func Test_ScoreHand_CorrectlyRanksHands(t *testing.T) {
 	}
 }
 
+func Test_BestHand_SevenCards(t *testing.T) {
+	testCases := []struct {
+		hand         deck.Hand
+		expectedHand deck.Hand
+		expectedName poker.HandName
+	}{
+		// three pairs
+		{
+			hand:         parseHand("2h 2d 7c 7s 9c 9s Kd"),
+			expectedHand: parseHand("9c 9s 7c 7s Kd"),
+			expectedName: poker.TwoPairs,
+		},
+		// two trebles
+		{
+			hand:         parseHand("4h 4d 4c 8s 8c 8d Qd"),
+			expectedHand: parseHand("8s 8c 8d 4h 4d"),
+			expectedName: poker.FullHouse,
+		},
+		// six suited cards
+		{
+			hand:         parseHand("2h 5h 7h 9h Jh Kh Ad"),
+			expectedHand: parseHand("5h 7h 9h Jh Kh"),
+			expectedName: poker.Flush,
+		},
+		// straight flush below a higher straight
+		{
+			hand:         parseHand("5h 6h 7h 8h 9h Ts 2c"),
+			expectedHand: parseHand("5h 6h 7h 8h 9h"),
+			expectedName: poker.StraightFlush,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := poker.BestHand(testCase.hand)
+
+		if actual.Name != testCase.expectedName {
+			t.Errorf("❌ BestHand did not return expected type.  Expected: %v.  Actual: %v.", testCase.expectedName, actual.Name)
+		}
+
+		if !slices.Equal(testCase.expectedHand, actual.Hand) {
+			t.Errorf("❌ BestHand did not return expected cards.  Expected: %v.  Actual: %v.", testCase.expectedHand, actual.Hand)
+		}
+	}
+}
+
+func Test_BestHand_IgnoresJokers(t *testing.T) {
+	hand := append(parseHand("Ah Kh Qh Jh 2c"), deck.RedJoker, deck.BlackJoker)
+	expected := poker.BestHand(parseHand("Ah Kh Qh Jh 2c"))
+
+	actual := poker.BestHand(hand)
+
+	if actual.Name != expected.Name || actual.Score != expected.Score || !slices.Equal(expected.Hand, actual.Hand) {
+		t.Errorf("❌ Unexpected hand.  Expected: %v.  Actual: %v.", expected, actual)
+	}
+
+	if len(hand) != 7 || !hand[5].IsJoker() {
+		t.Errorf("❌ Expected the cards to be unchanged.  Actual: %v.", hand)
+	}
+}
+
 // Generates a hand from a string.
 // Example: "2d 3c th ks" returns a slice of four cards:
 //   - Card{Rank: Two, Suit: Diamonds}
@@ -413,6 +482,7 @@ func Test_ScoreHand_CorrectlyRanksHands(t *testing.T) {
 //   - Card{Rank: King, Suit: Spades}
 //
 // Helper util.  Use to make test setup code less verbose and easier to read.
+// Unlike deck.ParseHand, duplicates are allowed.
 func parseHand(hand string) deck.Hand {
 	var result deck.Hand
 
@@ -420,37 +490,13 @@ func parseHand(hand string) deck.Hand {
 	elements := strings.Split(hand, space)
 
 	for _, element := range elements {
-		var rank deck.Rank
-		switch element[0] {
-		case 't', 'T':
-			rank = deck.Ten
-		case 'j', 'J':
-			rank = deck.Jack
-		case 'q', 'Q':
-			rank = deck.Queen
-		case 'k', 'K':
-			rank = deck.King
-		case 'a', 'A':
-			rank = deck.Ace
-		default:
-			i, _ := strconv.ParseInt(string(element[0]), 10, 64)
-			rank = deck.Rank(i)
-		}
-
-		var suit deck.Suit
-		switch element[1] {
-		case 'c', 'C':
-			suit = deck.Clubs
-		case 'd', 'D':
-			suit = deck.Diamonds
-		case 'h', 'H':
-			suit = deck.Hearts
-		case 's', 'S':
-			suit = deck.Spades
+		card, err := deck.ParseCard(element)
+		if err != nil {
+			panic(err)
 		}
 
-		result = append(result, deck.Card{Rank: rank, Suit: suit})
+		result = append(result, card)
 	}
 
-	return deck.Hand(result)
+	return result
 }
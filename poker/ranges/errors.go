@@ -0,0 +1,21 @@
+package ranges
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// Returned when parsing notation that does not contain any hands.
+	ErrEmptyRange = errors.New("range does not contain any hands")
+)
+
+// Returned when range notation cannot be parsed.
+type ErrInvalidRange struct {
+	Input  string
+	Reason string
+}
+
+func (e ErrInvalidRange) Error() string {
+	return fmt.Sprintf("Cannot parse range %q.  %v.", e.Input, e.Reason)
+}
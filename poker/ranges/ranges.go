@@ -0,0 +1,376 @@
+// Package ranges describes the hole cards a Texas hold'em player might hold.
+//
+// Ranges are written in the notation used by most poker tools.  Example: "QQ+, AKs, A5s-A2s, KQo".
+// Each range expands to combos: the specific pairs of cards a player could be dealt.
+package ranges
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/David-Rushton/card-collection/deck"
+	"github.com/David-Rushton/card-collection/poker"
+)
+
+const (
+	// Rank characters, from weakest to strongest.  Aces are high.
+	rankCodes = "23456789TJQKA"
+
+	suited  = 's'
+	offsuit = 'o'
+)
+
+var suits = []deck.Suit{deck.Clubs, deck.Diamonds, deck.Hearts, deck.Spades}
+
+// Two specific hole cards, and how likely a player is to hold them.
+type Combo struct {
+	// The higher ranked card first.
+	Cards [2]deck.Card
+
+	// Between 0 and 1.  One means the combo is always played.
+	Weight float64
+}
+
+// Returns the combo's cards as a set.
+func (c Combo) Set() deck.CardSet {
+	return deck.NewCardSet(c.Cards[0], c.Cards[1])
+}
+
+// The combos a player might hold.
+type Range []Combo
+
+// Returns the combos described by range notation.
+// Hands are separated by commas.  Each hand is one of:
+//
+//   - A pair, such as QQ.  QQ+ adds every higher pair.  QQ-99 adds every pair between.
+//   - Two ranks, such as AK.  An s suffix keeps suited combos only, and an o offsuit combos only.
+//     ATs+ raises the second rank until it meets the first: ATs, AJs, AQs.
+//     A5s-A2s adds every hand between, sharing the first rank.
+//   - Two specific cards, such as AhKh.
+//
+// A hand may end with a weight, between 0 and 1.  Example: AKo:0.5 plays offsuit ace-king half the
+// time.  When hands overlap, the last weight wins.
+//
+// All notation is case-insensitive, apart from the s and o suffixes which must be lowercase.
+// Returns ErrEmptyRange when there are no hands, and ErrInvalidRange when a hand cannot be parsed.
+func Parse(notation string) (Range, error) {
+	var result Range
+	positions := make(map[deck.CardSet]int)
+
+	for _, token := range strings.Split(notation, ",") {
+		token = strings.TrimSpace(token)
+		if token == "" {
+			continue
+		}
+
+		combos, err := parseToken(token)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, combo := range combos {
+			if i, ok := positions[combo.Set()]; ok {
+				result[i].Weight = combo.Weight
+				continue
+			}
+
+			positions[combo.Set()] = len(result)
+			result = append(result, combo)
+		}
+	}
+
+	if len(result) == 0 {
+		return nil, ErrEmptyRange
+	}
+
+	return result, nil
+}
+
+// Returns the combos that do not contain any of the cards.
+// Use to remove combos blocked by the board, or by other known cards.
+func (r Range) Without(cards ...deck.Card) Range {
+	blocked := deck.NewCardSet(cards...)
+
+	var result Range
+	for _, combo := range r {
+		if combo.Set().Intersect(blocked) == 0 {
+			result = append(result, combo)
+		}
+	}
+
+	return result
+}
+
+// Returns the number of combos, adjusted by their weights.
+func (r Range) Weight() float64 {
+	var total float64
+	for _, combo := range r {
+		total += combo.Weight
+	}
+
+	return total
+}
+
+// Returns the combos as holdings, for use in a poker.EquityRequest.
+func (r Range) Holdings() []poker.Holding {
+	result := make([]poker.Holding, len(r))
+	for i, combo := range r {
+		result[i] = poker.Holding{Cards: combo.Set(), Weight: combo.Weight}
+	}
+
+	return result
+}
+
+// Calculates each range's chance of winning, by sampling hole cards and boards.
+// Combos blocked by the board or dead cards are ignored.
+// Use poker.CalculateEquity, with Holdings, to control the number of trials or the seed.
+func Equity(ctx context.Context, board, dead deck.Hand, ranges ...Range) (poker.EquityResult, error) {
+	request := poker.EquityRequest{
+		Board:  board,
+		Dead:   dead,
+		Ranges: make([][]poker.Holding, len(ranges)),
+	}
+
+	for i, r := range ranges {
+		request.Ranges[i] = r.Holdings()
+	}
+
+	return poker.CalculateEquity(ctx, request)
+}
+
+// Returns the combos described by a single hand, with its weight.
+func parseToken(token string) ([]Combo, error) {
+	hand, weight, err := parseWeight(token)
+	if err != nil {
+		return nil, err
+	}
+
+	var combos [][2]deck.Card
+	switch {
+	case len(hand) == 4 && strings.ContainsRune("cdhsCDHS", rune(hand[1])):
+		combos, err = parseCards(token, hand)
+
+	case strings.HasSuffix(hand, "+"):
+		combos, err = parsePlus(token, strings.TrimSuffix(hand, "+"))
+
+	case strings.Contains(hand, "-"):
+		first, last, _ := strings.Cut(hand, "-")
+		combos, err = parseSpan(token, first, last)
+
+	default:
+		var c class
+		if c, err = parseClass(token, hand); err == nil {
+			combos = c.combos()
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Combo, len(combos))
+	for i, cards := range combos {
+		result[i] = Combo{Cards: cards, Weight: weight}
+	}
+
+	return result, nil
+}
+
+// Splits the optional weight from a hand.  Hands without a weight are always played.
+func parseWeight(token string) (string, float64, error) {
+	hand, weightText, found := strings.Cut(token, ":")
+	if !found {
+		return token, 1, nil
+	}
+
+	weight, err := strconv.ParseFloat(strings.TrimSpace(weightText), 64)
+	if err != nil || weight <= 0 || weight > 1 {
+		return "", 0, ErrInvalidRange{Input: token, Reason: "Expected a weight greater than 0, and no more than 1"}
+	}
+
+	return strings.TrimSpace(hand), weight, nil
+}
+
+// Parses two specific cards.  Example: AhKh.
+func parseCards(token, hand string) ([][2]deck.Card, error) {
+	first, err := deck.ParseCard(hand[:2])
+	if err != nil {
+		return nil, ErrInvalidRange{Input: token, Reason: err.Error()}
+	}
+
+	second, err := deck.ParseCard(hand[2:])
+	if err != nil {
+		return nil, ErrInvalidRange{Input: token, Reason: err.Error()}
+	}
+
+	if first == second {
+		return nil, ErrInvalidRange{Input: token, Reason: "Expected two different cards"}
+	}
+
+	if strength(second.Rank) > strength(first.Rank) {
+		first, second = second, first
+	}
+
+	return [][2]deck.Card{{first, second}}, nil
+}
+
+// Parses a hand followed by a plus.  Example: QQ+ or ATs+.
+func parsePlus(token, hand string) ([][2]deck.Card, error) {
+	c, err := parseClass(token, hand)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pairs climb to aces.  Other hands climb until the second rank meets the first.
+	top := c.high - 1
+	if c.isPair() {
+		top = len(rankCodes) - 1
+	}
+
+	var result [][2]deck.Card
+	for low := c.low; low <= top; low++ {
+		next := class{high: c.high, low: low, suit: c.suit}
+		if c.isPair() {
+			next.high = low
+		}
+
+		result = append(result, next.combos()...)
+	}
+
+	return result, nil
+}
+
+// Parses two hands joined by a dash.  Example: QQ-99 or A5s-A2s.
+func parseSpan(token, first, last string) ([][2]deck.Card, error) {
+	from, err := parseClass(token, first)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := parseClass(token, last)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case from.isPair() != to.isPair():
+		return nil, ErrInvalidRange{Input: token, Reason: "Cannot join a pair to another hand"}
+	case !from.isPair() && from.high != to.high:
+		return nil, ErrInvalidRange{Input: token, Reason: "Expected both hands to share the first rank"}
+	case from.suit != to.suit:
+		return nil, ErrInvalidRange{Input: token, Reason: "Expected both hands to share the same suffix"}
+	}
+
+	low, high := min(from.low, to.low), max(from.low, to.low)
+
+	var result [][2]deck.Card
+	for rank := low; rank <= high; rank++ {
+		next := class{high: from.high, low: rank, suit: from.suit}
+		if from.isPair() {
+			next.high = rank
+		}
+
+		result = append(result, next.combos()...)
+	}
+
+	return result, nil
+}
+
+// A group of combos sharing the same ranks.  Example: AKs.
+type class struct {
+	// Rank strengths, where two is 0 and ace is 12.  High is never less than low.
+	high int
+	low  int
+
+	// Suited, offsuit or zero for both.
+	suit byte
+}
+
+// Parses two ranks, with an optional suited or offsuit suffix.  Example: AK, AKs or QQ.
+func parseClass(token, hand string) (class, error) {
+	if len(hand) < 2 || len(hand) > 3 {
+		return class{}, ErrInvalidRange{Input: token, Reason: "Expected two ranks, such as AK or QQ"}
+	}
+
+	var result class
+	for i, target := range []*int{&result.high, &result.low} {
+		*target = strings.IndexByte(rankCodes, upper(hand[i]))
+		if *target < 0 {
+			return class{}, ErrInvalidRange{Input: token, Reason: fmt.Sprintf("Unknown rank %q", hand[i])}
+		}
+	}
+
+	if result.low > result.high {
+		result.high, result.low = result.low, result.high
+	}
+
+	if len(hand) == 3 {
+		result.suit = hand[2]
+		if result.suit != suited && result.suit != offsuit {
+			return class{}, ErrInvalidRange{Input: token, Reason: fmt.Sprintf("Unknown suffix %q", result.suit)}
+		}
+
+		if result.isPair() {
+			return class{}, ErrInvalidRange{Input: token, Reason: "Pairs cannot be suited or offsuit"}
+		}
+	}
+
+	return result, nil
+}
+
+func (c class) isPair() bool {
+	return c.high == c.low
+}
+
+// Returns every combo in the class.
+// Pairs have 6 combos, suited hands 4 and offsuit hands 12.
+func (c class) combos() [][2]deck.Card {
+	high := rankAt(c.high)
+	low := rankAt(c.low)
+
+	var result [][2]deck.Card
+	for i, first := range suits {
+		for j, second := range suits {
+			switch {
+			case c.isPair() && j <= i:
+				continue
+			case c.suit == suited && first != second:
+				continue
+			case c.suit == offsuit && first == second:
+				continue
+			}
+
+			result = append(result, [2]deck.Card{{Rank: high, Suit: first}, {Rank: low, Suit: second}})
+		}
+	}
+
+	return result
+}
+
+// Returns the rank with the given strength.  Two is 0 and ace is 12.
+func rankAt(strength int) deck.Rank {
+	if strength == len(rankCodes)-1 {
+		return deck.Ace
+	}
+
+	return deck.Rank(strength + int(deck.Two))
+}
+
+// Returns the strength of a rank.  Two is 0 and ace is 12.
+func strength(rank deck.Rank) int {
+	if rank == deck.Ace {
+		return len(rankCodes) - 1
+	}
+
+	return int(rank) - int(deck.Two)
+}
+
+func upper(b byte) byte {
+	if b >= 'a' && b <= 'z' {
+		return b - 'a' + 'A'
+	}
+
+	return b
+}
@@ -0,0 +1,139 @@
+package ranges_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/David-Rushton/card-collection/deck"
+	"github.com/David-Rushton/card-collection/poker/ranges"
+)
+
+func Test_Parse_CountsCombos(t *testing.T) {
+	testCases := []struct {
+		notation string
+		expected int
+	}{
+		{"QQ", 6},
+		{"AKs", 4},
+		{"AKo", 12},
+		{"AK", 16},
+		{"KA", 16},
+		{"AhKh", 1},
+		{"QQ+", 18},
+		{"99-QQ", 24},
+		{"ATs+", 16},
+		{"A5s-A2s", 16},
+		{"QQ+, AKs, A5s-A2s, KQo", 50},
+		{"AK, AKs", 16},
+		{"  tt , 9c8c ", 7},
+	}
+
+	for _, testCase := range testCases {
+		actual, err := ranges.Parse(testCase.notation)
+		if err != nil {
+			t.Errorf("❌ Unexpected error for %q: %v.", testCase.notation, err)
+			continue
+		}
+
+		if len(actual) != testCase.expected {
+			t.Errorf("❌ Unexpected combos for %q.  Expected: %v.  Actual: %v.", testCase.notation, testCase.expected, len(actual))
+		}
+	}
+}
+
+func Test_Parse_ReturnsExpectedCombos(t *testing.T) {
+	actual, err := ranges.Parse("A5s-A4s")
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	var seen deck.CardSet
+	for _, combo := range actual {
+		if combo.Cards[0].Rank != deck.Ace || combo.Cards[0].Suit != combo.Cards[1].Suit {
+			t.Errorf("❌ Unexpected combo: %v.", combo.Cards)
+		}
+
+		if combo.Cards[1].Rank != deck.Four && combo.Cards[1].Rank != deck.Five {
+			t.Errorf("❌ Unexpected kicker: %v.", combo.Cards)
+		}
+
+		seen = seen.Union(combo.Set())
+	}
+
+	if seen.Count() != 12 {
+		t.Errorf("❌ Expected 12 distinct cards.  Actual: %v.", seen.Count())
+	}
+}
+
+func Test_Parse_AppliesWeights(t *testing.T) {
+	actual, err := ranges.Parse("AKo:0.5, AK, AKs:0.25")
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	// AK overrides the offsuit weight.  AKs then overrides the suited weight.
+	if expected := 12 + 4*0.25; math.Abs(actual.Weight()-expected) > 1e-9 {
+		t.Errorf("❌ Unexpected weight.  Expected: %v.  Actual: %v.", expected, actual.Weight())
+	}
+}
+
+func Test_Parse_ReturnsError_WhenNotationInvalid(t *testing.T) {
+	testCases := []string{
+		"AX",
+		"A",
+		"AKx",
+		"QQs",
+		"AKs-QJs",
+		"QQ-AKs",
+		"A5s-A2o",
+		"AhAh",
+		"AhKz",
+		"AK:0",
+		"AK:1.5",
+		"AK:half",
+	}
+
+	for _, testCase := range testCases {
+		var invalid ranges.ErrInvalidRange
+		if _, err := ranges.Parse(testCase); !errors.As(err, &invalid) {
+			t.Errorf("❌ Expected ErrInvalidRange for %q.  Actual: %v.", testCase, err)
+		}
+	}
+
+	if _, err := ranges.Parse(" , "); !errors.Is(err, ranges.ErrEmptyRange) {
+		t.Errorf("❌ Expected ErrEmptyRange.  Actual: %v.", err)
+	}
+}
+
+func Test_Without_RemovesBlockedCombos(t *testing.T) {
+	aces, _ := ranges.Parse("AA")
+	board, _ := deck.ParseHand("Ah 7c 2d")
+
+	actual := aces.Without(board...)
+
+	if len(actual) != 3 {
+		t.Errorf("❌ Expected 3 combos without the ace of hearts.  Actual: %v.", len(actual))
+	}
+
+	for _, combo := range actual {
+		if combo.Set().Contains(deck.Card{Rank: deck.Ace, Suit: deck.Hearts}) {
+			t.Errorf("❌ Blocked combo returned: %v.", combo.Cards)
+		}
+	}
+}
+
+func Test_Equity_RangeVsRange(t *testing.T) {
+	aces, _ := ranges.Parse("AA")
+	kings, _ := ranges.Parse("KK")
+
+	actual, err := ranges.Equity(context.Background(), nil, nil, aces, kings)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if math.Abs(actual.Players[0].Share-0.82) > 0.01 {
+		t.Errorf("❌ Unexpected equity for aces.  Expected: about 0.82.  Actual: %v.", actual.Players[0].Share)
+	}
+}
@@ -0,0 +1,98 @@
+package poker
+
+import (
+	"slices"
+
+	"github.com/David-Rushton/card-collection/deck"
+)
+
+// Short deck, or 6+, hold'em is played with a 36 card deck.  Twos to fives are removed.
+//
+// With fewer cards, flushes are harder to make than full houses, so a flush beats a full house.
+// Aces are still high and low, but the lowest straight is A-6-7-8-9.  Some games also rank three of
+// a kind above a straight.
+//
+// Hands keep their usual names.  Only the score, which decides who wins, changes.
+
+// Returns the hands in the variant's order, weakest first.
+func (v Variant) handOrder() []HandName {
+	switch v {
+	case ShortDeck:
+		return []HandName{HighCard, Pair, TwoPairs, ThreeOfAKind, Straight, FullHouse, Flush, FourOfAKind, StraightFlush, RoyalFlush}
+	case ShortDeckTripsBeatStraight:
+		return []HandName{HighCard, Pair, TwoPairs, Straight, ThreeOfAKind, FullHouse, Flush, FourOfAKind, StraightFlush, RoyalFlush}
+	default:
+		return []HandName{HighCard, Pair, TwoPairs, ThreeOfAKind, Straight, Flush, FullHouse, FourOfAKind, StraightFlush, RoyalFlush}
+	}
+}
+
+// Returns the strength of a hand name, within the variant.  Bigger is better.
+func (v Variant) handRank(name HandName) int {
+	return slices.Index(v.handOrder(), name)
+}
+
+// Returns the stronger of two hand names, within the variant.
+func (v Variant) stronger(a, b HandName) HandName {
+	if v.handRank(b) > v.handRank(a) {
+		return b
+	}
+
+	return a
+}
+
+// Scores a hand, using the variant's order of hand names.
+// Matches scoreHand for variants that use the usual order.
+func (v Variant) scoreHand(name HandName, hand deck.Hand) int64 {
+	const typeMultiplier = 10_000_000_000
+
+	score := scoreHand(name, hand)
+	return score + int64(v.handRank(name)-int(name))*typeMultiplier
+}
+
+func (v Variant) isShortDeck() bool {
+	return v == ShortDeck || v == ShortDeckTripsBeatStraight
+}
+
+// Returns the best short deck hand, by checking every five card combination.
+func (v Variant) bestShortDeckHand(cards deck.Hand) (HandName, deck.Hand) {
+	var bestName HandName
+	var best deck.Hand
+	bestScore := int64(-1)
+	for five := range subsets(cards, 5) {
+		name, hand := shortDeckStraight(getBestHand(five, deck.Hand{}))
+		if score := v.scoreHand(name, hand); score > bestScore {
+			bestName, best, bestScore = name, hand, score
+		}
+	}
+
+	return bestName, best
+}
+
+// Finds the A-6-7-8-9 straight, which getBestHand does not know about.
+// Like the usual wheel, the straight is held ace first.
+func shortDeckStraight(name HandName, hand deck.Hand) (HandName, deck.Hand) {
+	if name != HighCard && name != Flush {
+		return name, hand
+	}
+
+	ranks := make([]deck.Rank, len(hand))
+	for i, card := range hand {
+		ranks[i] = card.Rank
+	}
+	slices.Sort(ranks)
+
+	if !slices.Equal(ranks, []deck.Rank{deck.Ace, deck.Six, deck.Seven, deck.Eight, deck.Nine}) {
+		return name, hand
+	}
+
+	straight := slices.Clone(hand)
+	slices.SortFunc(straight, func(a, b deck.Card) int {
+		return int(a.Rank) - int(b.Rank)
+	})
+
+	if name == Flush {
+		return StraightFlush, straight
+	}
+
+	return Straight, straight
+}
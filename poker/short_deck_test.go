@@ -0,0 +1,90 @@
+package poker_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/David-Rushton/card-collection/deck"
+	"github.com/David-Rushton/card-collection/poker"
+)
+
+func Test_ShortDeck_FlushBeatsFullHouse(t *testing.T) {
+	board := parseHand("6h 8h Th Kc Kd")
+	players := map[string]deck.Hand{
+		"alice": parseHand("Ah 9h"),
+		"bob":   parseHand("Ks 6c"),
+	}
+
+	assertWinner(t, poker.Holdem, board, players, "bob")
+	assertWinner(t, poker.ShortDeck, board, players, "alice")
+}
+
+func Test_ShortDeck_TripsBeatStraight_WhenEnabled(t *testing.T) {
+	board := parseHand("6c 7d 8h Kc Ks")
+	players := map[string]deck.Hand{
+		"alice": parseHand("As 9s"),
+		"bob":   parseHand("Kh Jd"),
+	}
+
+	assertWinner(t, poker.ShortDeck, board, players, "alice")
+	assertWinner(t, poker.ShortDeckTripsBeatStraight, board, players, "bob")
+}
+
+func Test_ShortDeck_FindsAceToNineStraight(t *testing.T) {
+	testCases := []struct {
+		board    string
+		hole     string
+		expected poker.HandName
+	}{
+		{"6c 7d 8h Kc Qd", "As 9s", poker.Straight},
+		{"6h 7h 8h Kc Qd", "Ah 9h", poker.StraightFlush},
+	}
+
+	for _, testCase := range testCases {
+		actual, err := poker.ShortDeck.Evaluate(parseHand(testCase.board), parseHand(testCase.hole))
+		if err != nil {
+			t.Errorf("❌ Unexpected error: %v.", err)
+			continue
+		}
+
+		if actual.Name != testCase.expected || actual.Hand[0].Rank != deck.Ace || actual.Hand[4].Rank != deck.Nine {
+			t.Errorf("❌ Unexpected hand.  Expected: %v from A to 9.  Actual: %v %v.", testCase.expected, actual.Name, actual.Hand)
+		}
+	}
+
+	// The lowest straight.
+	low, _ := poker.ShortDeck.Evaluate(parseHand("6c 7d 8h Kc Qd"), parseHand("As 9s"))
+	higher, _ := poker.ShortDeck.Evaluate(parseHand("6c 7d 8h Kc Qd"), parseHand("9c Ts"))
+	if low.Score >= higher.Score {
+		t.Errorf("❌ Expected A-6-7-8-9 to lose to 6-7-8-9-T.")
+	}
+}
+
+func Test_ShortDeck_RejectsRemovedRanks(t *testing.T) {
+	var invalid deck.ErrInvalidCard
+	if _, err := poker.ShortDeck.Evaluate(parseHand("6c 7d 8h"), parseHand("5s 9s")); !errors.As(err, &invalid) {
+		t.Errorf("❌ Expected ErrInvalidCard.  Actual: %v.", err)
+	}
+
+	actual, err := poker.ShortDeck.Outs(parseHand("6c 7d Kh"), parseHand("8c 9c"))
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if actual.Unseen != 31 {
+		t.Errorf("❌ Expected 31 unseen cards in a short deck.  Actual: %v.", actual.Unseen)
+	}
+}
+
+func assertWinner(t *testing.T, variant poker.Variant, board deck.Hand, players map[string]deck.Hand, expected string) {
+	t.Helper()
+
+	actual, err := variant.Showdown(board, players)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if winners := actual.Winners(); len(winners) != 1 || winners[0] != expected {
+		t.Errorf("❌ Unexpected winners.  Expected: %v.  Actual: %v.", expected, winners)
+	}
+}
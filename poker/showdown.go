@@ -0,0 +1,185 @@
+package poker
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/David-Rushton/card-collection/deck"
+)
+
+// A player's hand at showdown.
+type Contender struct {
+	Player string
+	Evaluation
+}
+
+// Players who finished in the same position.
+// When a place contains more than one player, they split the pot.
+type Place struct {
+	// 1 for the winners.  Tied players share a position, and the next position is skipped.
+	// Example: two players tie for first, and the next player is third.
+	Position   int
+	Contenders []Contender
+}
+
+// The finishing order at showdown.  The winners are first.
+type ShowdownResult []Place
+
+// Returns the players who won the pot.
+func (r ShowdownResult) Winners() []string {
+	if len(r) == 0 {
+		return nil
+	}
+
+	return r[0].Players()
+}
+
+// Returns true when more than one player won.
+func (r ShowdownResult) IsSplitPot() bool {
+	return len(r) > 0 && len(r[0].Contenders) > 1
+}
+
+// Returns the players who finished in this place, in name order.
+func (p Place) Players() []string {
+	result := make([]string, len(p.Contenders))
+	for i, contender := range p.Contenders {
+		result[i] = contender.Player
+	}
+
+	return result
+}
+
+// Ranks Texas hold'em players from best hand to worst.
+// See Variant.Showdown.
+func Showdown(board deck.Hand, players map[string]deck.Hand) (ShowdownResult, error) {
+	return Holdem.Showdown(board, players)
+}
+
+// Ranks players from best hand to worst.
+// players maps each player's name to their hole cards.
+//
+// Returns ErrNoPlayers when there are no players, and deck.ErrDuplicateCard when a card is held
+// by more than one player.  Errors evaluating a player's hand are returned as ErrPlayerHand.
+func (v Variant) Showdown(board deck.Hand, players map[string]deck.Hand) (ShowdownResult, error) {
+	contenders, err := v.evaluatePlayers(board, players)
+	if err != nil {
+		return nil, err
+	}
+
+	return rank(contenders, func(c Contender) int64 { return c.Score }), nil
+}
+
+// The outcome of a hi-lo showdown.
+type HiLoResult struct {
+	// Players ranked by their high hand.
+	High ShowdownResult
+
+	// Players with a qualifying low, ranked by their low hand.
+	// Empty when no player has a low.
+	Low ShowdownResult
+
+	// The fraction of the pot won by each player.  Players who win nothing are not included.
+	//
+	// The best high hand wins half the pot, and the best low hand the other half.  When there is
+	// no low the high hand scoops the whole pot.  Tied players split their half, so two players
+	// tied for low are quartered, each taking a quarter of the pot.
+	Shares map[string]float64
+}
+
+// Ranks players by their high and low hands, and splits the pot between them.
+// For variants that are not hi-lo, no player has a low, and the high hand wins the whole pot.
+// Returns the same errors as Showdown.
+func (v Variant) HiLoShowdown(board deck.Hand, players map[string]deck.Hand) (HiLoResult, error) {
+	contenders, err := v.evaluatePlayers(board, players)
+	if err != nil {
+		return HiLoResult{}, err
+	}
+
+	var lows []Contender
+	for _, contender := range contenders {
+		if contender.Low != nil {
+			lows = append(lows, contender)
+		}
+	}
+
+	result := HiLoResult{
+		High:   rank(contenders, func(c Contender) int64 { return c.Score }),
+		Low:    rank(lows, func(c Contender) int64 { return c.Low.Score }),
+		Shares: make(map[string]float64),
+	}
+
+	highShare := 1.0
+	if len(result.Low) > 0 {
+		highShare = 0.5
+		result.Low.split(result.Shares, 0.5)
+	}
+	result.High.split(result.Shares, highShare)
+
+	return result, nil
+}
+
+// Divides a share of the pot between the winners.
+func (r ShowdownResult) split(shares map[string]float64, share float64) {
+	winners := r.Winners()
+	for _, player := range winners {
+		shares[player] += share / float64(len(winners))
+	}
+}
+
+// Evaluates each player's hand, in name order.
+func (v Variant) evaluatePlayers(board deck.Hand, players map[string]deck.Hand) ([]Contender, error) {
+	if len(players) == 0 {
+		return nil, ErrNoPlayers
+	}
+
+	// Sort by name, so the result does not depend on map order.
+	names := make([]string, 0, len(players))
+	for name := range players {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	contenders := make([]Contender, 0, len(players))
+	used := deck.NewCardSet(board...)
+	for _, name := range names {
+		hole := players[name]
+		for _, card := range hole {
+			if used.Contains(card) {
+				return nil, deck.ErrDuplicateCard{Card: card}
+			}
+
+			used.Add(card)
+		}
+
+		evaluation, err := v.Evaluate(board, hole)
+		if err != nil {
+			return nil, ErrPlayerHand{Player: name, Err: err}
+		}
+
+		contenders = append(contenders, Contender{Player: name, Evaluation: evaluation})
+	}
+
+	return contenders, nil
+}
+
+// Groups contenders into places, best score first.  Ties remain in name order.
+func rank(contenders []Contender, score func(Contender) int64) ShowdownResult {
+	sorted := slices.Clone(contenders)
+	slices.SortStableFunc(sorted, func(a, b Contender) int {
+		return cmp.Compare(score(b), score(a))
+	})
+
+	// Group players with equal scores.
+	var result ShowdownResult
+	for i, contender := range sorted {
+		if i > 0 && score(contender) == score(sorted[i-1]) {
+			last := &result[len(result)-1]
+			last.Contenders = append(last.Contenders, contender)
+			continue
+		}
+
+		result = append(result, Place{Position: i + 1, Contenders: []Contender{contender}})
+	}
+
+	return result
+}
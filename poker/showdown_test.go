@@ -0,0 +1,106 @@
+package poker_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/David-Rushton/card-collection/deck"
+	"github.com/David-Rushton/card-collection/poker"
+)
+
+func Test_Showdown_RanksPlayers(t *testing.T) {
+	board := parseHand("Ah Kd 8c 5s 2h")
+	players := map[string]deck.Hand{
+		"alice": parseHand("As 3c"),
+		"bob":   parseHand("Kc Ks"),
+		"carol": parseHand("7d 9d"),
+	}
+
+	actual, err := poker.Showdown(board, players)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	expected := [][]string{{"bob"}, {"alice"}, {"carol"}}
+	if len(actual) != len(expected) {
+		t.Fatalf("❌ Unexpected number of places.  Expected: %v.  Actual: %v.", len(expected), len(actual))
+	}
+
+	for i, place := range actual {
+		if !slices.Equal(place.Players(), expected[i]) || place.Position != i+1 {
+			t.Errorf("❌ Unexpected place %v.  Expected: %v.  Actual: %v at position %v.", i+1, expected[i], place.Players(), place.Position)
+		}
+	}
+
+	if actual.IsSplitPot() {
+		t.Errorf("❌ Unexpected split pot.")
+	}
+}
+
+func Test_Showdown_DetectsSplitPot(t *testing.T) {
+	board := parseHand("Ah Kd Qc Js Th")
+	players := map[string]deck.Hand{
+		"dave":  parseHand("2c 3c"),
+		"alice": parseHand("2d 3d"),
+		"bob":   parseHand("Kc 4s"),
+	}
+
+	actual, err := poker.Showdown(board, players)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if !actual.IsSplitPot() {
+		t.Errorf("❌ Missing split pot.")
+	}
+
+	expected := []string{"alice", "bob", "dave"}
+	if !slices.Equal(actual.Winners(), expected) {
+		t.Errorf("❌ Unexpected winners.  Expected: %v.  Actual: %v.", expected, actual.Winners())
+	}
+}
+
+func Test_Showdown_SkipsPositionsAfterTie(t *testing.T) {
+	board := parseHand("Ah Kd 8c 5s 2h")
+	players := map[string]deck.Hand{
+		"alice": parseHand("Ac 3c"),
+		"bob":   parseHand("As 3d"),
+		"carol": parseHand("7d 9d"),
+	}
+
+	actual, err := poker.Showdown(board, players)
+	if err != nil {
+		t.Fatalf("❌ Unexpected error: %v.", err)
+	}
+
+	if len(actual) != 2 || actual[1].Position != 3 {
+		t.Errorf("❌ Unexpected places: %v.", actual)
+	}
+}
+
+func Test_Showdown_ReturnsErrors(t *testing.T) {
+	board := parseHand("Ah Kd 8c 5s 2h")
+
+	if _, err := poker.Showdown(board, nil); err != poker.ErrNoPlayers {
+		t.Errorf("❌ Unexpected error.  Expected: ErrNoPlayers.  Actual: %v.", err)
+	}
+
+	_, err := poker.Showdown(board, map[string]deck.Hand{
+		"alice": parseHand("Ac 3c"),
+		"bob":   parseHand("Ac 3d"),
+	})
+	var duplicate deck.ErrDuplicateCard
+	if !errors.As(err, &duplicate) {
+		t.Errorf("❌ Unexpected error.  Expected: ErrDuplicateCard.  Actual: %v.", err)
+	}
+
+	_, err = poker.Showdown(board, map[string]deck.Hand{
+		"alice": parseHand("Ac"),
+	})
+	var playerHand poker.ErrPlayerHand
+	var count poker.ErrInvalidCardCount
+	if !errors.As(err, &playerHand) || playerHand.Player != "alice" || !errors.As(err, &count) {
+		t.Errorf("❌ Unexpected error.  Expected: ErrPlayerHand wrapping ErrInvalidCardCount.  Actual: %v.", err)
+	}
+}
@@ -0,0 +1,116 @@
+package poker
+
+import (
+	"slices"
+
+	"github.com/David-Rushton/card-collection/deck"
+)
+
+// A style of poker.
+// Each variant has its own rules for the number of cards dealt, and how they may be combined.
+type Variant int
+
+const (
+	// Texas hold'em.
+	// Two hole cards, and up to five board cards.  The best five of the seven are used.
+	Holdem Variant = iota
+
+	// Omaha.
+	// Four hole cards, and up to five board cards.  Hands use exactly two hole cards and three
+	// board cards.
+	Omaha
+
+	// Five card Omaha, also known as PLO5.  Played like Omaha, with five hole cards.
+	Omaha5
+
+	// Six card Omaha, also known as PLO6.  Played like Omaha, with six hole cards.
+	Omaha6
+
+	// Omaha hi-lo, eight or better.
+	// The pot is split between the best high hand and the best low hand.  See HiLoShowdown.
+	OmahaHiLo
+
+	// Short deck, or 6+, hold'em.
+	// Played like hold'em with a 36 card deck.  A flush beats a full house, and A-6-7-8-9 is a
+	// straight.
+	ShortDeck
+
+	// Short deck hold'em, where three of a kind also beats a straight.
+	ShortDeckTripsBeatStraight
+)
+
+// The number of cards a variant deals, and uses.
+type cardCounts struct {
+	minHole  int
+	maxHole  int
+	minBoard int
+	maxBoard int
+
+	// The exact number of hole cards each hand must use.  Zero allows any number.
+	holeUsed int
+}
+
+func (v Variant) cardCounts() cardCounts {
+	switch v {
+	case Omaha, OmahaHiLo:
+		return cardCounts{minHole: 4, maxHole: 4, minBoard: 3, maxBoard: 5, holeUsed: 2}
+	case Omaha5:
+		return cardCounts{minHole: 5, maxHole: 5, minBoard: 3, maxBoard: 5, holeUsed: 2}
+	case Omaha6:
+		return cardCounts{minHole: 6, maxHole: 6, minBoard: 3, maxBoard: 5, holeUsed: 2}
+	default:
+		return cardCounts{minHole: 2, maxHole: 2, minBoard: 3, maxBoard: 5}
+	}
+}
+
+// Returns the deck the variant is played with.
+func (v Variant) Composition() deck.Composition {
+	if v.isShortDeck() {
+		return deck.ShortDeck
+	}
+
+	return deck.Standard
+}
+
+// Returns true when the pot is split between the best high and low hands.
+func (v Variant) IsHiLo() bool {
+	return v == OmahaHiLo
+}
+
+// Checks the number of cards is correct for the variant, and that no card appears twice.
+// Cards must come from the variant's deck.
+func (v Variant) validate(board, hole deck.Hand) error {
+	ranks := v.Composition().Ranks()
+
+	counts := v.cardCounts()
+	if len(hole) < counts.minHole || len(hole) > counts.maxHole {
+		return ErrInvalidCardCount{Cards: "hole", Min: counts.minHole, Max: counts.maxHole, Actual: len(hole)}
+	}
+
+	if len(board) < counts.minBoard || len(board) > counts.maxBoard {
+		return ErrInvalidCardCount{Cards: "board", Min: counts.minBoard, Max: counts.maxBoard, Actual: len(board)}
+	}
+
+	var seen deck.CardSet
+	for _, card := range board.Append(hole, len(hole)) {
+		if card.IsJoker() {
+			return ErrJokersNotSupported
+		}
+
+		if !card.IsValid() {
+			return deck.ErrInvalidCard{Input: card.ShortString(), Reason: "Rank or suit out of range"}
+		}
+
+		if !slices.Contains(ranks, card.Rank) {
+			return deck.ErrInvalidCard{Input: card.ShortString(), Reason: "Rank not found in the variant's deck"}
+		}
+
+		if seen.Contains(card) {
+			return deck.ErrDuplicateCard{Card: card}
+		}
+
+		seen.Add(card)
+	}
+
+	return nil
+}